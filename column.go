@@ -2,10 +2,13 @@ package ddlmaker
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
 	"github.com/nao1215/ddl-maker/dialect"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
 )
 
 // column is the model for mapping structure field to table column.
@@ -18,6 +21,71 @@ type column struct {
 	tag string
 	// dialect is interface that eliminates differences in DB drivers.
 	dialect dialect.Dialect
+	// sqlType is SQL type registered for the type. If it's not empty, it's used as is.
+	sqlType string
+	// valuerTypeName is name of type that driver.Valuer returns. It's used when the dialect does not know typeName.
+	valuerTypeName string
+	// table is the table name. It's used for the name of the enum type.
+	table string
+	// enumValues is the allowed values of the enum column.
+	enumValues []string
+	// literalKind is the kind of the literal of "default" tag. The zero value writes it as is.
+	literalKind literalKind
+}
+
+// literalKind is the kind of the literal of the default value decided by the Go type of the column.
+type literalKind int
+
+const (
+	// rawLiteral is written as is (e.g. the default value of time.Time column).
+	rawLiteral literalKind = iota
+	// stringLiteral is quoted and escaped for the dialect.
+	stringLiteral
+	// numberLiteral is validated that it is number.
+	numberLiteral
+	// boolLiteral is converted for the dialect (e.g. TRUE or 1).
+	boolLiteral
+)
+
+// numberPattern is the numeric literal of the default value.
+var numberPattern = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+
+// attributeFormatter is for type assertion. A dialect implements it when the order
+// or the availability of NULL, DEFAULT and auto-increment differs from attribute().
+type attributeFormatter interface {
+	FormatAttribute(sqlType string, null bool, defaultValue *string, auto bool) (string, error)
+}
+
+// inlineCommenter is for type assertion. A dialect implements it when the column
+// comment is written in the column definition (e.g. COMMENT 'comment' in MySQL).
+// Otherwise, the table template renders Comment() of the column.
+type inlineCommenter interface {
+	CommentSQL(comment string) string
+}
+
+// enumChecker is for type assertion. A dialect implements it when the database has
+// no enum type, so that the allowed values are restricted by CHECK constraint.
+type enumChecker interface {
+	EnumCheck(column string, values []string) string
+}
+
+// stringLiteralFormatter is for type assertion. A dialect implements it when the string
+// literal differs from standard SQL (e.g. backslash is the escape character in MySQL).
+type stringLiteralFormatter interface {
+	StringLiteral(s string) string
+}
+
+// boolLiteralFormatter is for type assertion. A dialect implements it when the boolean
+// literal is not TRUE / FALSE (e.g. 1 / 0 for the database that has no boolean type).
+type boolLiteralFormatter interface {
+	BoolLiteral(b bool) string
+}
+
+// generatedFormatter is for type assertion. A dialect implements it when the syntax or
+// the restrictions of the generated column differ from "GENERATED ALWAYS AS (...)".
+// It returns the column definition without the column name.
+type generatedFormatter interface {
+	FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error)
 }
 
 // newColumn return initialized column.
@@ -40,41 +108,211 @@ func (c column) size() (uint64, error) {
 	return strconv.ParseUint(specs["size"], 10, 64)
 }
 
-// specs converts each tag of a golang structure into a key-value format map
-func (c column) specs() map[string]string {
-	elems := strings.Split(c.tag, ",")
-	specs := make(map[string]string, len(elems))
-	for _, elem := range elems {
-		ss := strings.Split(elem, "=")
-		switch len(ss) {
-		case 1:
-			specs[ss[0]] = ""
-		case 2:
-			specs[ss[0]] = ss[1]
+// typeOption return the options of SQL type specified by "size", "precision" and "scale" tag.
+// "size=<precision>.<scale>" is the same as "precision=<precision>,scale=<scale>".
+func (c column) typeOption() (sqltype.Option, error) {
+	specs := c.specs()
+	var opt sqltype.Option
+	var err error
+
+	// "type=<type>(<size>)" or "type=<type>(<precision>,<scale>)". The non-numeric
+	// arguments (e.g. "geometry(Point,4326)") are not the options.
+	args := c.typeArgs()
+	if !numericArgs(args) {
+		args = nil
+	}
+	switch len(args) {
+	case 0:
+	case 1:
+		if opt.Size, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+			return opt, err
+		}
+	case 2:
+		if opt.Precision, err = strconv.ParseUint(args[0], 10, 64); err != nil {
+			return opt, err
+		}
+		if opt.Scale, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+			return opt, err
+		}
+	default:
+		return opt, fmt.Errorf("%w: too many type arguments: %s", ErrInvalidTag, specs["type"])
+	}
+
+	if size := specs["size"]; strings.Contains(size, ".") {
+		ps := strings.SplitN(size, ".", 2)
+		if opt.Precision, err = strconv.ParseUint(ps[0], 10, 64); err != nil {
+			return opt, err
+		}
+		if opt.Scale, err = strconv.ParseUint(ps[1], 10, 64); err != nil {
+			return opt, err
+		}
+	} else if size != "" {
+		if opt.Size, err = c.size(); err != nil {
+			return opt, err
+		}
+	}
+
+	if precision, ok := specs["precision"]; ok {
+		if opt.Precision, err = strconv.ParseUint(precision, 10, 64); err != nil {
+			return opt, err
+		}
+	}
+	if scale, ok := specs["scale"]; ok {
+		if opt.Scale, err = strconv.ParseUint(scale, 10, 64); err != nil {
+			return opt, err
 		}
 	}
 
+	if opt.Scale > opt.Precision {
+		return opt, fmt.Errorf("%w: precision=%d, scale=%d", ErrScaleExceedsPrecision, opt.Precision, opt.Scale)
+	}
+
+	if len(c.enumValues) > 0 {
+		opt.Enum = c.enumValues
+		opt.EnumName = c.EnumName()
+	}
+	return opt, nil
+}
+
+// specs converts each tag of a golang structure into a key-value format map.
+// The malformed tag is reported by structParser, so it is treated as empty here.
+func (c column) specs() map[string]string {
+	tagSpecs, _ := parseTag(c.tag)
+	specs := make(map[string]string, len(tagSpecs))
+	for _, spec := range tagSpecs {
+		specs[spec.key] = spec.value
+	}
+
 	return specs
 }
 
+// null return whether "null" tag is specified. "notnull" tag takes precedence.
+func (c column) null() bool {
+	specs := c.specs()
+	_, null := specs["null"]
+	_, notNull := specs["notnull"]
+	return null && !notNull
+}
+
+// Auto return whether "auto" tag is specified. Table templates use it for
+// the databases that need extra statements for auto-increment (e.g. sequence).
+// The generated column is not auto-increment even if it has "auto" tag.
+func (c column) Auto() bool {
+	specs := c.specs()
+	_, ok := specs["auto"]
+	_, generated := specs["generated"]
+	return ok && !generated
+}
+
+// HasSpec return whether the tag key is specified. Table templates use it for
+// the dialect-specific tags (e.g. "invisible" for MariaDB).
+func (c column) HasSpec(key string) bool {
+	_, ok := c.specs()[key]
+	return ok
+}
+
+// defaultValue return the default value of the column in SQL. "default" tag is the literal,
+// and it is quoted or converted for the dialect by the Go type of the column. "default_expr"
+// tag is the expression (e.g. CURRENT_TIMESTAMP), and it is written as is.
+// If neither is specified, return nil.
+func (c column) defaultValue() (*string, error) {
+	specs := c.specs()
+	expression, hasExpression := specs["default_expr"]
+	value, hasValue := specs["default"]
+
+	switch {
+	case hasExpression && hasValue:
+		return nil, fmt.Errorf("%w: \"default\" and \"default_expr\" can not be specified together", ErrInvalidDefault)
+	case (hasExpression || hasValue) && (c.Auto() || c.HasSpec("sequence")):
+		// The auto increment column (and the column of "sequence" tag for MariaDB)
+		// has the default value from the sequence or the identity.
+		return nil, fmt.Errorf("%w: the auto increment column can not have the default value", ErrInvalidDefault)
+	case hasExpression && expression == "":
+		return nil, fmt.Errorf("%w: \"default_expr\" requires the expression", ErrInvalidDefault)
+	case hasExpression && c.literalKind == numberLiteral && isStringLiteral(expression):
+		return nil, fmt.Errorf("%w: string literal %s is not number", ErrInvalidDefault, expression)
+	case hasExpression && c.literalKind == boolLiteral && isStringLiteral(expression):
+		return nil, fmt.Errorf("%w: string literal %s is not bool", ErrInvalidDefault, expression)
+	case hasExpression:
+		return &expression, nil
+	case !hasValue:
+		return nil, nil
+	}
+
+	literal, err := c.literal(value)
+	if err != nil {
+		return nil, err
+	}
+	return &literal, nil
+}
+
+// isStringLiteral return whether the expression is the string literal (e.g. 'abc' or ('abc')).
+func isStringLiteral(expression string) bool {
+	e := strings.TrimSpace(expression)
+	for len(e) >= 2 && e[0] == '(' && e[len(e)-1] == ')' {
+		e = strings.TrimSpace(e[1 : len(e)-1])
+	}
+	return len(e) >= 2 && e[0] == '\'' && e[len(e)-1] == '\''
+}
+
+// literal return the SQL literal of the value of "default" tag. NULL is written as is
+// regardless of the column type. The enclosing quotes of value are removed.
+func (c column) literal(value string) (string, error) {
+	if strings.EqualFold(value, "NULL") {
+		return "NULL", nil
+	}
+
+	switch c.literalKind {
+	case stringLiteral:
+		s := unquote(value)
+		if len(c.enumValues) > 0 && !containsString(c.enumValues, s) {
+			return "", fmt.Errorf("%w: %q is not the enum value", ErrInvalidDefault, s)
+		}
+		if f, ok := c.dialect.(stringLiteralFormatter); ok {
+			return f.StringLiteral(s), nil
+		}
+		return query.SingleQuote(s), nil
+	case numberLiteral:
+		n := unquote(value)
+		if !numberPattern.MatchString(n) {
+			return "", fmt.Errorf("%w: %q is not number", ErrInvalidDefault, value)
+		}
+		return n, nil
+	case boolLiteral:
+		b, err := strconv.ParseBool(unquote(value))
+		if err != nil {
+			return "", fmt.Errorf("%w: %q is not bool", ErrInvalidDefault, value)
+		}
+		if f, ok := c.dialect.(boolLiteralFormatter); ok {
+			return f.BoolLiteral(b), nil
+		}
+		return strings.ToUpper(strconv.FormatBool(b)), nil
+	default:
+		return value, nil
+	}
+}
+
 // attribute returns DB attributes (constraints)
 func (c column) attribute() string {
 	var attributes []string
 	specs := c.specs()
 
-	if _, ok := specs["null"]; ok {
+	if c.null() {
 		attributes = append(attributes, "NULL")
 	} else {
 		attributes = append(attributes, "NOT NULL")
 	}
 
-	if defaultVal, ok := specs["default"]; ok {
+	// The invalid default value is reported by structParser and ToSQL.
+	if defaultVal, _ := c.defaultValue(); defaultVal != nil {
 		attributes = append(attributes, "DEFAULT")
-		attributes = append(attributes, defaultVal)
+		attributes = append(attributes, *defaultVal)
 	}
 
 	if _, ok := specs["auto"]; ok {
-		attributes = append(attributes, c.dialect.AutoIncrement())
+		if autoIncrement := c.dialect.AutoIncrement(); autoIncrement != "" {
+			attributes = append(attributes, autoIncrement)
+		}
 	}
 
 	return strings.Join(attributes, " ")
@@ -85,28 +323,192 @@ func (c column) Name() string {
 	return c.name
 }
 
-// ToSQL convert struct field to sql.
-func (c column) ToSQL() (string, error) {
-	var columnType string
-	specs := c.specs()
+// Type return type name of the column. It is the value of "type" tag without the
+// arguments (e.g. "decimal" of "decimal(10,2)") if specified, otherwise the name of
+// type that defined in golang.
+func (c column) Type() string {
+	if typeName, ok := c.specs()["type"]; ok {
+		if i := strings.Index(typeName, "("); i >= 0 {
+			return strings.TrimSpace(typeName[:i])
+		}
+		return typeName
+	}
+	return c.typeName
+}
 
-	if typeName, ok := specs["type"]; ok {
-		columnType = typeName
-	} else {
-		columnType = c.typeName
+// typeArgs return the arguments of "type" tag (e.g. ["10", "2"] of "decimal(10,2)").
+func (c column) typeArgs() []string {
+	typeName := c.specs()["type"]
+	start := strings.Index(typeName, "(")
+	if start < 0 || !strings.HasSuffix(typeName, ")") {
+		return nil
+	}
+
+	args := strings.Split(typeName[start+1:len(typeName)-1], ",")
+	for i := range args {
+		args[i] = strings.TrimSpace(args[i])
 	}
+	return args
+}
 
+// numericArgs return whether all arguments of "type" tag are unsigned integers.
+func numericArgs(args []string) bool {
+	for _, arg := range args {
+		if _, err := strconv.ParseUint(arg, 10, 64); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// EnumValues return the allowed values of the enum column. If the column is not enum, return nil.
+// Table templates use it for the databases that define the enum type separately (e.g. PostgreSQL).
+func (c column) EnumValues() []string {
+	return c.enumValues
+}
+
+// EnumName return the name of the enum type. It is "<table>_<column>".
+func (c column) EnumName() string {
+	return fmt.Sprintf("%s_%s", c.table, c.name)
+}
+
+// EnumLiterals return the allowed values of the enum column as the comma separated string literals.
+func (c column) EnumLiterals() string {
+	f, ok := c.dialect.(stringLiteralFormatter)
+	if !ok {
+		return query.SingleQuoteList(c.enumValues)
+	}
+	literals := make([]string, 0, len(c.enumValues))
+	for _, v := range c.enumValues {
+		literals = append(literals, f.StringLiteral(v))
+	}
+	return strings.Join(literals, ", ")
+}
+
+// Comment return the column comment specified by "comment" tag. The enclosing quotes are removed.
+func (c column) Comment() string {
+	return unquote(c.specs()["comment"])
+}
+
+// QuotedComment return the column comment as the string literal.
+func (c column) QuotedComment() string {
+	return query.SingleQuote(c.Comment())
+}
+
+// ToSQL convert struct field to sql.
+func (c column) ToSQL() (string, error) {
+	columnType := c.Type()
 	name := c.dialect.Quote(c.name)
-	size, err := c.size()
+	opt, err := c.typeOption()
 	if err != nil {
 		return "", fmt.Errorf("error size parse error: %w", err)
 	}
 
-	sql, err := c.dialect.ToSQL(columnType, size)
+	sql, err := c.sqlTypeOf(columnType, opt)
 	if err != nil {
 		return "", fmt.Errorf("can not convert struct field to sql: %w", err)
 	}
+	var definition string
+	if expression, storage, _ := generatedColumn(c.specs()); expression != "" {
+		definition, err = c.generatedDefinition(sql, expression, storage)
+	} else {
+		definition, err = c.definition(sql)
+	}
+	if err != nil {
+		return "", fmt.Errorf("can not convert struct field to sql: %w", err)
+	}
+	if f, ok := c.dialect.(enumChecker); ok && len(c.enumValues) > 0 {
+		definition += " " + f.EnumCheck(c.name, c.enumValues)
+	}
+	if f, ok := c.dialect.(inlineCommenter); ok && c.Comment() != "" {
+		definition += " " + f.CommentSQL(c.Comment())
+	}
+
+	return fmt.Sprintf("%s %s", name, definition), nil
+}
+
+// definition return SQL type and attributes of the column.
+func (c column) definition(sqlType string) (string, error) {
+	defaultValue, err := c.defaultValue()
+	if err != nil {
+		return "", err
+	}
 	attribute := c.attribute()
+	if f, ok := c.dialect.(attributeFormatter); ok {
+		if attribute, err = f.FormatAttribute(sqlType, c.null(), defaultValue, c.Auto()); err != nil {
+			return "", err
+		}
+	}
+	return strings.TrimSpace(sqlType + " " + attribute), nil
+}
+
+// generatedDefinition return SQL type and attributes of the generated column.
+// DEFAULT and auto-increment can not be used with the generated column, so they are omitted.
+func (c column) generatedDefinition(sqlType, expression string, storage sqltype.Storage) (string, error) {
+	if f, ok := c.dialect.(generatedFormatter); ok {
+		return f.FormatGenerated(sqlType, expression, storage, c.null())
+	}
+
+	definition := fmt.Sprintf("%s GENERATED ALWAYS AS (%s)", sqlType, expression)
+	if storage != sqltype.StorageDefault {
+		definition += " " + string(storage)
+	}
+	if c.null() {
+		return definition + " NULL", nil
+	}
+	return definition + " NOT NULL", nil
+}
+
+// generatedColumn return the expression and the storage kind of the generated column
+// specified by "generated" and "storage" tags. If the column is not generated, the
+// expression is empty. "storage" is "virtual" or "stored", and it requires "generated".
+func generatedColumn(specs map[string]string) (string, sqltype.Storage, error) {
+	storageValue, hasStorage := specs["storage"]
+	expression, ok := specs["generated"]
+	if !ok {
+		if hasStorage {
+			return "", sqltype.StorageDefault, fmt.Errorf("%w: \"storage\" requires \"generated\"", ErrInvalidTag)
+		}
+		return "", sqltype.StorageDefault, nil
+	}
+	if expression == "" {
+		return "", sqltype.StorageDefault, fmt.Errorf("%w: \"generated\" requires the expression", ErrInvalidTag)
+	}
+
+	switch strings.ToLower(storageValue) {
+	case "":
+		return expression, sqltype.StorageDefault, nil
+	case "virtual":
+		return expression, sqltype.Virtual, nil
+	case "stored":
+		return expression, sqltype.Stored, nil
+	default:
+		return "", sqltype.StorageDefault, fmt.Errorf("%w: storage must be virtual or stored: %q", ErrInvalidTag, storageValue)
+	}
+}
 
-	return fmt.Sprintf("%s %s %s", name, sql, attribute), nil
+// sqlTypeOf return SQL type of the column. The registered SQL type takes precedence.
+// The type with non-numeric arguments of "type" tag (e.g. geometry(Point,4326)) and
+// the type with arguments that the dialect does not know (e.g. varchar(20)) are
+// written as is. If the dialect does not know the other type, the type that
+// driver.Valuer returns is used.
+func (c column) sqlTypeOf(columnType string, opt sqltype.Option) (string, error) {
+	if c.sqlType != "" {
+		return c.sqlType, nil
+	}
+	if args := c.typeArgs(); len(args) > 0 && !numericArgs(args) {
+		return c.specs()["type"], nil
+	}
+
+	sql, err := c.dialect.ToSQL(columnType, opt)
+	switch {
+	case err == nil:
+		return sql, nil
+	case len(c.typeArgs()) > 0:
+		return c.specs()["type"], nil
+	case c.valuerTypeName != "":
+		return c.dialect.ToSQL(c.valuerTypeName, opt)
+	default:
+		return sql, err
+	}
 }
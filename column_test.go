@@ -6,7 +6,17 @@ import (
 	"testing"
 
 	"github.com/nao1215/ddl-maker/dialect"
+	"github.com/nao1215/ddl-maker/dialect/clickhouse"
+	"github.com/nao1215/ddl-maker/dialect/duckdb"
+	"github.com/nao1215/ddl-maker/dialect/mariadb"
+	"github.com/nao1215/ddl-maker/dialect/mock"
+	"github.com/nao1215/ddl-maker/dialect/mssql"
 	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/oracle"
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/spanner"
+	"github.com/nao1215/ddl-maker/dialect/sqlite"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 )
 
 func TestSize(t *testing.T) {
@@ -28,6 +38,87 @@ func TestSize(t *testing.T) {
 	}
 }
 
+func TestTypeOption(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    sqltype.Option
+		wantErr error
+	}{
+		{
+			name: "[Normal] no option",
+			want: sqltype.Option{},
+		},
+		{
+			name: "[Normal] size",
+			tag:  "size=10",
+			want: sqltype.Option{Size: 10},
+		},
+		{
+			name: "[Normal] precision and scale",
+			tag:  "precision=12,scale=2",
+			want: sqltype.Option{Precision: 12, Scale: 2},
+		},
+		{
+			name: "[Normal] precision and scale by size",
+			tag:  "size=12.2",
+			want: sqltype.Option{Precision: 12, Scale: 2},
+		},
+		{
+			name: "[Normal] precision tag overrides size",
+			tag:  "size=12.2,precision=20",
+			want: sqltype.Option{Precision: 20, Scale: 2},
+		},
+		{
+			name: "[Normal] size by type arguments",
+			tag:  "type=varchar(20)",
+			want: sqltype.Option{Size: 20},
+		},
+		{
+			name: "[Normal] precision and scale by type arguments",
+			tag:  "type=decimal(12, 2)",
+			want: sqltype.Option{Precision: 12, Scale: 2},
+		},
+		{
+			name: "[Normal] non-numeric type arguments are not options",
+			tag:  "type=geometry(Point,4326)",
+			want: sqltype.Option{},
+		},
+		{
+			name:    "[Error] too many type arguments",
+			tag:     "type=decimal(12,2,1)",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] scale without precision",
+			tag:     "scale=2",
+			wantErr: ErrScaleExceedsPrecision,
+		},
+		{
+			name:    "[Error] scale is greater than precision",
+			tag:     "size=2.4",
+			wantErr: ErrScaleExceedsPrecision,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := column{name: "dummy", tag: tt.tag}
+			got, err := c.typeOption()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("column.typeOption() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	c := column{name: "dummy", tag: "size=a.2"}
+	if _, err := c.typeOption(); err == nil {
+		t.Error("column.typeOption() does not return error for invalid precision")
+	}
+}
+
 func TestSpecs(t *testing.T) {
 	c := column{
 		name: "name",
@@ -57,6 +148,11 @@ func TestAttribute(t *testing.T) {
 		t.Fatalf("error column attribute. result:%s", c.attribute())
 	}
 
+	c.tag = "null,notnull"
+	if c.attribute() != "NOT NULL" {
+		t.Fatalf("error column attribute. result:%s", c.attribute())
+	}
+
 	c.tag = "default=0"
 	if c.attribute() != "NOT NULL DEFAULT 0" {
 		t.Fatalf("error column attribute. result:%s", c.attribute())
@@ -68,6 +164,225 @@ func TestAttribute(t *testing.T) {
 	}
 }
 
+func TestDefaultValue(t *testing.T) {
+	tests := []struct {
+		name        string
+		tag         string
+		literalKind literalKind
+		enumValues  []string
+		dialect     dialect.Dialect
+		want        string
+		wantErr     error
+	}{
+		{
+			name:        "[Normal] string literal is quoted",
+			tag:         "default=abc",
+			literalKind: stringLiteral,
+			dialect:     postgres.PostgreSQL{},
+			want:        "'abc'",
+		},
+		{
+			name:        "[Normal] quoted string literal is quoted again for the dialect",
+			tag:         `default='C:\dir, it''s'`,
+			literalKind: stringLiteral,
+			dialect:     mysql.MySQL{},
+			want:        `'C:\\dir, it''s'`,
+		},
+		{
+			name:        "[Normal] empty string literal",
+			tag:         "default=",
+			literalKind: stringLiteral,
+			dialect:     postgres.PostgreSQL{},
+			want:        "''",
+		},
+		{
+			name:        "[Normal] bool literal in standard sql",
+			tag:         "default=true",
+			literalKind: boolLiteral,
+			dialect:     postgres.PostgreSQL{},
+			want:        "TRUE",
+		},
+		{
+			name:        "[Normal] bool literal converted by dialect",
+			tag:         "default=false",
+			literalKind: boolLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "0",
+		},
+		{
+			name:        "[Normal] number literal",
+			tag:         "default=-1.5e3",
+			literalKind: numberLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "-1.5e3",
+		},
+		{
+			name:        "[Normal] NULL is not quoted",
+			tag:         "default=null",
+			literalKind: stringLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "NULL",
+		},
+		{
+			name:        "[Normal] expression is written as is",
+			tag:         "default_expr=lower('ABC')",
+			literalKind: stringLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "lower('ABC')",
+		},
+		{
+			name:    "[Normal] the value of unknown type is written as is",
+			tag:     "default=CURRENT_TIMESTAMP",
+			dialect: mysql.MySQL{},
+			want:    "CURRENT_TIMESTAMP",
+		},
+		{
+			name:        "[Normal] enum value",
+			tag:         "default=active",
+			literalKind: stringLiteral,
+			enumValues:  []string{"active", "inactive"},
+			dialect:     mysql.MySQL{},
+			want:        "'active'",
+		},
+		{
+			name:        "[Error] not enum value",
+			tag:         "default=deleted",
+			literalKind: stringLiteral,
+			enumValues:  []string{"active", "inactive"},
+			dialect:     mysql.MySQL{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:        "[Error] not number",
+			tag:         "default=abc",
+			literalKind: numberLiteral,
+			dialect:     mysql.MySQL{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:        "[Error] not bool",
+			tag:         "default=yes",
+			literalKind: boolLiteral,
+			dialect:     mysql.MySQL{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:    "[Error] default and default_expr",
+			tag:     "default=0,default_expr=now()",
+			dialect: mysql.MySQL{},
+			wantErr: ErrInvalidDefault,
+		},
+		{
+			name:        "[Normal] quoted string literal is converted by dialect",
+			tag:         "default='Bob''s room'",
+			literalKind: stringLiteral,
+			dialect:     mssql.SQLServer{},
+			want:        "N'Bob''s room'",
+		},
+		{
+			name:        "[Normal] string literal expression of string column",
+			tag:         "default_expr=(upper('abc'))",
+			literalKind: stringLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "(upper('abc'))",
+		},
+		{
+			name:    "[Error] default_expr without expression",
+			tag:     "default_expr=",
+			dialect: mysql.MySQL{},
+			wantErr: ErrInvalidDefault,
+		},
+		{
+			name:        "[Normal] expression of number column",
+			tag:         "default_expr=(1 + 2)",
+			literalKind: numberLiteral,
+			dialect:     mysql.MySQL{},
+			want:        "(1 + 2)",
+		},
+		{
+			name:        "[Error] string literal expression of number column",
+			tag:         "default_expr='abc'",
+			literalKind: numberLiteral,
+			dialect:     mysql.MySQL{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:        "[Error] string literal expression in parentheses of bool column",
+			tag:         "default_expr=('yes')",
+			literalKind: boolLiteral,
+			dialect:     postgres.PostgreSQL{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:        "[Error] default of auto increment column",
+			tag:         "auto,default=1",
+			literalKind: numberLiteral,
+			dialect:     duckdb.DuckDB{},
+			wantErr:     ErrInvalidDefault,
+		},
+		{
+			name:    "[Error] default_expr of sequence column",
+			tag:     "sequence,default_expr=uuid()",
+			dialect: mariadb.MariaDB{},
+			wantErr: ErrInvalidDefault,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := column{tag: tt.tag, literalKind: tt.literalKind, enumValues: tt.enumValues, dialect: tt.dialect}
+			got, err := c.defaultValue()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if got == nil || *got != tt.want {
+				t.Errorf("defaultValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuto(t *testing.T) {
+	c := column{dialect: mock.SQLMock{}}
+	if c.Auto() {
+		t.Fatal("auto tag is not specified")
+	}
+
+	c.tag = "auto"
+	if !c.Auto() {
+		t.Fatal("auto tag is specified")
+	}
+
+	// auto-increment is not written if dialect does not have it.
+	if c.attribute() != "NOT NULL" {
+		t.Fatalf("error column attribute. result:%s", c.attribute())
+	}
+}
+
+func TestHasSpec(t *testing.T) {
+	c := column{tag: "null,invisible"}
+	if !c.HasSpec("invisible") {
+		t.Fatal("invisible tag is specified")
+	}
+	if c.HasSpec("sequence") {
+		t.Fatal("sequence tag is not specified")
+	}
+}
+
+func TestType(t *testing.T) {
+	c := column{typeName: "[]uint8"}
+	if c.Type() != "[]uint8" {
+		t.Fatalf("error column type. result:%s", c.Type())
+	}
+
+	c.tag = "null,type=json"
+	if c.Type() != "json" {
+		t.Fatalf("error column type. result:%s", c.Type())
+	}
+}
+
 func TestToSQL(t *testing.T) {
 	t.Run("[Normal] int64 to BIGINT", func(t *testing.T) {
 		c := column{
@@ -86,6 +401,24 @@ func TestToSQL(t *testing.T) {
 		}
 	})
 
+	t.Run("[Normal] big.Rat to DECIMAL(12,2)", func(t *testing.T) {
+		c := column{
+			typeName: "*big.Rat",
+			name:     "price",
+			tag:      "size=12.2",
+			dialect:  mysql.MySQL{},
+		}
+
+		got, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "`price` DECIMAL(12,2) NOT NULL"
+		if want != got {
+			t.Fatalf("mismatch: want=%s, got=%s", want, got)
+		}
+	})
+
 	t.Run("[Normal] uint64 to BIGINT unsigned", func(t *testing.T) {
 		c := column{
 			typeName: "uint64",
@@ -138,6 +471,55 @@ func TestToSQL(t *testing.T) {
 		}
 	})
 
+	t.Run("[Normal] dialect formats attribute by itself", func(t *testing.T) {
+		c := column{
+			typeName: "bool",
+			name:     "active",
+			tag:      "default=1",
+			dialect:  oracle.Oracle{},
+		}
+		got, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := `"ACTIVE" NUMBER(1) DEFAULT 1 NOT NULL`
+		if want != got {
+			t.Fatalf("mismatch: want=%s, got=%s", want, got)
+		}
+	})
+
+	t.Run("[Normal] generated column omits default and auto-increment", func(t *testing.T) {
+		c := column{
+			typeName: "int64",
+			name:     "total",
+			tag:      "generated=price * quantity,storage=stored,default=0,auto",
+			dialect:  mysql.MySQL{},
+		}
+		got, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "`total` BIGINT GENERATED ALWAYS AS (price * quantity) STORED NOT NULL"
+		if want != got {
+			t.Fatalf("mismatch: want=%s, got=%s", want, got)
+		}
+		if c.Auto() {
+			t.Fatal("generated column is not auto-increment")
+		}
+	})
+
+	t.Run("[Error] dialect does not support stored generated column", func(t *testing.T) {
+		c := column{
+			typeName: "int64",
+			name:     "total",
+			tag:      "generated=price * quantity,storage=stored",
+			dialect:  oracle.Oracle{},
+		}
+		if _, err := c.ToSQL(); !errors.Is(err, oracle.ErrStoredGeneratedColumn) {
+			t.Errorf("mismatch: want=%v, got=%v", oracle.ErrStoredGeneratedColumn, err)
+		}
+	})
+
 	t.Run("[Error] can not calculate column size (column size is minus)", func(t *testing.T) {
 		c := column{
 			typeName: "string",
@@ -166,12 +548,161 @@ func TestToSQL(t *testing.T) {
 		if got == nil {
 			t.Fatal("type name is unknown. however, error did not occure")
 		}
-		if !errors.As(got, &mysql.ErrInvalidType) {
+		if !errors.Is(got, mysql.ErrInvalidType) {
 			t.Errorf("mismatch: want=%v, got=%v", mysql.ErrInvalidType, got)
 		}
 	})
 }
 
+func TestToSQLWithTypeArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		dialect dialect.Dialect
+		want    string
+	}{
+		{
+			name:    "[Normal] the dialect converts the numeric arguments",
+			tag:     "type=decimal(10, 2)",
+			dialect: mysql.MySQL{},
+			want:    "`price` DECIMAL(10,2) NOT NULL",
+		},
+		{
+			name:    "[Normal] the dialect converts the numeric arguments to its own type",
+			tag:     "type=decimal(10, 2)",
+			dialect: oracle.Oracle{},
+			want:    `"PRICE" NUMBER(10,2) NOT NULL`,
+		},
+		{
+			name:    "[Normal] the dialect type without precision drops the arguments",
+			tag:     "type=decimal(10, 2)",
+			dialect: spanner.Spanner{},
+			want:    "`price` NUMERIC NOT NULL",
+		},
+		{
+			name:    "[Normal] the dialect type affinity keeps the arguments",
+			tag:     "type=decimal(10, 2)",
+			dialect: sqlite.SQLite{},
+			want:    "`price` NUMERIC(10,2) NOT NULL",
+		},
+		{
+			name:    "[Normal] ClickHouse decimal",
+			tag:     "type=decimal(10, 2)",
+			dialect: clickhouse.ClickHouse{},
+			want:    "`price` Decimal(10, 2)",
+		},
+		{
+			name:    "[Normal] unknown type with numeric arguments is written as is",
+			tag:     "type=varchar(20)",
+			dialect: postgres.PostgreSQL{},
+			want:    `"price" varchar(20) NOT NULL`,
+		},
+		{
+			name:    "[Normal] type with non-numeric arguments is written as is",
+			tag:     "type=geometry(Point,4326)",
+			dialect: postgres.PostgreSQL{},
+			want:    `"price" geometry(Point,4326) NOT NULL`,
+		},
+		{
+			name:    "[Normal] known type with non-numeric arguments is written as is",
+			tag:     "type=varchar(max)",
+			dialect: mssql.SQLServer{},
+			want:    "[price] varchar(max) NOT NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := column{typeName: "float64", name: "price", tag: tt.tag, dialect: tt.dialect}
+			got, err := c.ToSQL()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch: want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGeneratedColumn(t *testing.T) {
+	tests := []struct {
+		name           string
+		specs          map[string]string
+		wantExpression string
+		wantStorage    sqltype.Storage
+		wantErr        error
+	}{
+		{
+			name:  "[Normal] not generated column",
+			specs: map[string]string{"null": ""},
+		},
+		{
+			name:           "[Normal] storage is not specified",
+			specs:          map[string]string{"generated": "lower(name)"},
+			wantExpression: "lower(name)",
+		},
+		{
+			name:           "[Normal] storage is case-insensitive",
+			specs:          map[string]string{"generated": "lower(name)", "storage": "VIRTUAL"},
+			wantExpression: "lower(name)",
+			wantStorage:    sqltype.Virtual,
+		},
+		{
+			name:    "[Error] generated without expression",
+			specs:   map[string]string{"generated": ""},
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] storage without generated",
+			specs:   map[string]string{"storage": "stored"},
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] unknown storage",
+			specs:   map[string]string{"generated": "lower(name)", "storage": "persistent"},
+			wantErr: ErrInvalidTag,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expression, storage, err := generatedColumn(tt.specs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if expression != tt.wantExpression || storage != tt.wantStorage {
+				t.Errorf("generatedColumn() = %q, %q, want %q, %q", expression, storage, tt.wantExpression, tt.wantStorage)
+			}
+		})
+	}
+}
+
+func TestEnumLiterals(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		want    string
+	}{
+		{
+			name:    "[Normal] standard SQL string literals",
+			dialect: postgres.PostgreSQL{},
+			want:    `'open', 'it''s closed'`,
+		},
+		{
+			name:    "[Normal] string literals of the dialect",
+			dialect: spanner.Spanner{},
+			want:    `'open', 'it\'s closed'`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := column{name: "status", enumValues: []string{"open", "it's closed"}, dialect: tt.dialect}
+			if got := c.EnumLiterals(); got != tt.want {
+				t.Errorf("column.EnumLiterals() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func Test_column_Name(t *testing.T) {
 	type fields struct {
 		name     string
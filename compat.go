@@ -0,0 +1,290 @@
+package ddlmaker
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// GORMTAG is struct tag of gorm (https://gorm.io)
+	GORMTAG = "gorm"
+	// DBTAG is struct tag of sqlx (https://github.com/jmoiron/sqlx)
+	DBTAG = "db"
+	// BUNTAG is struct tag of bun (https://bun.uptrace.dev)
+	BUNTAG = "bun"
+)
+
+var (
+	// varcharPattern matches "varchar(<size>)" type
+	varcharPattern = regexp.MustCompile(`^varchar\((\d+)\)$`)
+	// typeNamePattern matches type name without size (e.g. text)
+	typeNamePattern = regexp.MustCompile(`^[a-z]+$`)
+	// typeArgsPattern matches type name with arguments (e.g. decimal(10,2))
+	typeArgsPattern = regexp.MustCompile(`^[a-z][a-z0-9]*\([^()]*\)$`)
+	// tagValueEscaper escapes the characters that have meaning in "ddl" tag value.
+	tagValueEscaper = strings.NewReplacer(`\`, `\\`, `,`, `\,`, `'`, `\'`, `"`, `\"`, `(`, `\(`, `)`, `\)`)
+)
+
+// IgnoredTagKey is the key of the compatible tag (gorm, db, bun) that is not mapped to "ddl" tag.
+type IgnoredTagKey struct {
+	// Struct is the name of the struct that has the field
+	Struct string
+	// Field is the name of the struct field
+	Field string
+	// Tag is the name of the compatible tag (e.g. gorm)
+	Tag string
+	// Key is the ignored key
+	Key string
+}
+
+// String Stringer for IgnoredTagKey
+func (k IgnoredTagKey) String() string {
+	return fmt.Sprintf("%s.%s: %s tag key %q is ignored", k.Struct, k.Field, k.Tag, k.Key)
+}
+
+// convertedTag is the compatible tag converted to "ddl" tag.
+type convertedTag struct {
+	// specs is "key" or "key=value" of "ddl" tag
+	specs      []string
+	constraint fieldConstraint
+	ignored    []string
+	// invalid is the elements whose values can not be converted. They are errors in strict mode.
+	invalid []string
+}
+
+func (ct *convertedTag) add(key, value string) {
+	if value == "" {
+		ct.specs = append(ct.specs, key)
+		return
+	}
+	ct.specs = append(ct.specs, key+"="+escapeTagValue(value))
+}
+
+// escapeTagValue return the value that "ddl" tag reads as is. The value that is not
+// read as is (e.g. it has commas outside quotes or an unbalanced quote) is escaped by backslash.
+func escapeTagValue(value string) string {
+	if specs, err := parseTag("v=" + value); err == nil && len(specs) == 1 && specs[0].value == value {
+		return value
+	}
+	return tagValueEscaper.Replace(value)
+}
+
+func (ct *convertedTag) addType(elem, value string) {
+	typeName := strings.ToLower(value)
+	if m := varcharPattern.FindStringSubmatch(typeName); m != nil {
+		ct.add("size", m[1])
+		return
+	}
+	if typeNamePattern.MatchString(typeName) || typeArgsPattern.MatchString(typeName) {
+		ct.add("type", typeName)
+		return
+	}
+	ct.invalid = append(ct.invalid, elem)
+}
+
+// has return whether the specs have the key.
+func (ct convertedTag) has(key string) bool {
+	for _, spec := range ct.specs {
+		if strings.SplitN(spec, "=", 2)[0] == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (ct *convertedTag) addIndex(name string, unique bool) {
+	ct.constraint.indexes = append(ct.constraint.indexes, tagIndex{name: name, unique: unique})
+}
+
+func (ct *convertedTag) ignore(key string) {
+	ct.ignored = append(ct.ignored, key)
+}
+
+// convertTag converts the compatible tag to "ddl" tag.
+func convertTag(tagName, value string) convertedTag {
+	switch tagName {
+	case GORMTAG:
+		return convertGormTag(value)
+	case DBTAG:
+		return convertDBTag(value)
+	case BUNTAG:
+		return convertBunTag(value)
+	}
+	return convertedTag{ignored: []string{value}}
+}
+
+// convertGormTag converts gorm tag (e.g. `gorm:"column:name;type:varchar(100);not null;index"`).
+// The column is NULL if the tag does not have "not null" like gorm.
+func convertGormTag(value string) convertedTag {
+	var ct convertedTag
+	for _, elem := range strings.Split(value, ";") {
+		elem = strings.TrimSpace(elem)
+		if elem == "" {
+			continue
+		}
+		kv := strings.SplitN(elem, ":", 2)
+		// gorm tag key is case insensitive, and "not null" equals to "NOT_NULL".
+		key := strings.NewReplacer(" ", "", "_", "").Replace(strings.ToLower(kv[0]))
+		var val string
+		if len(kv) == 2 {
+			val = strings.TrimSpace(kv[1])
+		}
+
+		switch key {
+		case "-":
+			ct.add(IGNORETAG, "")
+		case "column":
+			ct.add(NAMETAG, val)
+		case "type":
+			ct.addType(elem, val)
+		case "size":
+			ct.add("size", val)
+		case "default":
+			ct.add("default", val)
+		case "autoincrement":
+			ct.add("auto", "")
+		case "notnull":
+			ct.add("notnull", "")
+		case "primarykey":
+			ct.constraint.primaryKey = true
+		case "index", "uniqueindex":
+			ct.addGormIndex(val, key == "uniqueindex")
+		case "unique":
+			ct.addIndex("", true)
+		case "embedded":
+			ct.add(EMBEDDEDTAG, "")
+		case "embeddedprefix":
+			ct.add(PREFIXTAG, val)
+		case "foreignkey", "references", "many2many", "polymorphic", "jointable", "joinforeignkey", "joinreferences":
+			// The field is association. It's not column.
+			ct.add(IGNORETAG, "")
+		default:
+			ct.ignore(elem)
+		}
+	}
+
+	ct.addNull()
+	return ct
+}
+
+// addNull adds "null" key like gorm and bun. Their columns are nullable unless the
+// column has "not null" or it is primary key (or auto increment).
+func (ct *convertedTag) addNull() {
+	nullable := !ct.has("null") && !ct.has("notnull") && !ct.has("auto") && !ct.constraint.primaryKey
+	if nullable && !ct.has(IGNORETAG) && !ct.has(EMBEDDEDTAG) {
+		ct.add("null", "")
+	}
+}
+
+// addGormIndex adds gorm index. The value is "<name>,<option>,...".
+func (ct *convertedTag) addGormIndex(value string, unique bool) {
+	elems := strings.Split(value, ",")
+	for _, option := range elems[1:] {
+		switch strings.ToLower(strings.TrimSpace(option)) {
+		case "unique", "class:unique":
+			unique = true
+		default:
+			ct.ignore(option)
+		}
+	}
+	ct.addIndex(strings.TrimSpace(elems[0]), unique)
+}
+
+// convertDBTag converts sqlx tag (e.g. `db:"name"`).
+func convertDBTag(value string) convertedTag {
+	var ct convertedTag
+	switch name := strings.TrimSpace(value); name {
+	case "":
+	case "-":
+		ct.add(IGNORETAG, "")
+	default:
+		ct.add(NAMETAG, name)
+	}
+	return ct
+}
+
+// convertBunTag converts bun tag (e.g. `bun:"name,pk,type:varchar(100),notnull"`).
+// The first element is column name. The column is NULL if the tag does not have
+// "notnull" or "pk" like bun.
+func convertBunTag(value string) convertedTag {
+	var ct convertedTag
+	elems := splitOutsideParens(value, ',')
+	switch name := strings.TrimSpace(elems[0]); name {
+	case "":
+	case "-":
+		ct.add(IGNORETAG, "")
+		return ct
+	default:
+		if strings.Contains(name, ":") {
+			ct.ignore(name)
+		} else {
+			ct.add(NAMETAG, name)
+		}
+	}
+
+	for _, elem := range elems[1:] {
+		elem = strings.TrimSpace(elem)
+		if elem == "" {
+			continue
+		}
+		kv := strings.SplitN(elem, ":", 2)
+		var val string
+		if len(kv) == 2 {
+			val = kv[1]
+		}
+
+		switch kv[0] {
+		case "pk":
+			ct.constraint.primaryKey = true
+		case "autoincrement":
+			ct.add("auto", "")
+		case "type":
+			ct.addType(elem, val)
+		case "default":
+			ct.add("default", val)
+		case "notnull":
+			ct.add("notnull", "")
+		case "nullzero":
+			ct.add("null", "")
+		case "unique":
+			ct.addIndex(val, true)
+		case "embed":
+			ct.add(EMBEDDEDTAG, "")
+			ct.add(PREFIXTAG, val)
+		case "rel", "m2m", "scanonly":
+			// The field is relation. It's not column.
+			ct.add(IGNORETAG, "")
+		default:
+			ct.ignore(elem)
+		}
+	}
+	ct.addNull()
+	return ct
+}
+
+// splitOutsideParens splits s by sep. sep in parentheses and quotes does not split s
+// (e.g. "type:decimal(10,2)" is one element).
+func splitOutsideParens(s string, sep rune) []string {
+	var elems []string
+	var quote rune
+	depth, start := 0, 0
+	for i, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+		case r == '\'' || r == '"':
+			quote = r
+		case r == '(':
+			depth++
+		case r == ')' && depth > 0:
+			depth--
+		case r == sep && depth == 0:
+			elems = append(elems, s[start:i])
+			start = i + len(string(sep))
+		}
+	}
+	return append(elems, s[start:])
+}
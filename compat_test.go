@@ -0,0 +1,247 @@
+package ddlmaker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/nao1215/ddl-maker/dialect/mysql"
+)
+
+func TestConvertTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tagName string
+		value   string
+		want    convertedTag
+	}{
+		{
+			name:    "[Normal] gorm column, type, size, default and auto increment",
+			tagName: GORMTAG,
+			value:   "column:user_name;type:varchar(100);default:'guest';not null",
+			want:    convertedTag{specs: []string{"name=user_name", "size=100", "default='guest'", "notnull"}},
+		},
+		{
+			name:    "[Normal] gorm type name, primary key and auto increment",
+			tagName: GORMTAG,
+			value:   "type:TEXT;PRIMARY_KEY;autoIncrement;size:255",
+			want: convertedTag{
+				specs:      []string{"type=text", "auto", "size=255"},
+				constraint: fieldConstraint{primaryKey: true},
+			},
+		},
+		{
+			name:    "[Normal] gorm indexes",
+			tagName: GORMTAG,
+			value:   "index;index:idx_name,unique;uniqueIndex:uniq_email,sort:desc",
+			want: convertedTag{
+				constraint: fieldConstraint{indexes: []tagIndex{
+					{name: ""},
+					{name: "idx_name", unique: true},
+					{name: "uniq_email", unique: true},
+				}},
+				specs:   []string{"null"},
+				ignored: []string{"sort:desc"},
+			},
+		},
+		{
+			name:    "[Normal] gorm embedded, association and ignored keys",
+			tagName: GORMTAG,
+			value:   "embedded;embeddedPrefix:author_;foreignKey:UserID;comment:user name;type:decimal(10,2)",
+			want: convertedTag{
+				specs:   []string{"embedded", "prefix=author_", "-", "type=decimal(10,2)"},
+				ignored: []string{"comment:user name"},
+			},
+		},
+		{
+			name:    "[Normal] gorm values that have commas and quotes",
+			tagName: GORMTAG,
+			value:   "type:DECIMAL(10, 2);default:'a, b';not null",
+			want:    convertedTag{specs: []string{"type=decimal(10, 2)", "default='a, b'", "notnull"}},
+		},
+		{
+			name:    "[Normal] gorm values that are escaped",
+			tagName: GORMTAG,
+			value:   "default:a,b;not null",
+			want:    convertedTag{specs: []string{`default=a\,b`, "notnull"}},
+		},
+		{
+			name:    "[Normal] gorm column without not null is null",
+			tagName: GORMTAG,
+			value:   "column:nickname",
+			want:    convertedTag{specs: []string{"name=nickname", "null"}},
+		},
+		{
+			name:    "[Normal] gorm type that can not be converted",
+			tagName: GORMTAG,
+			value:   "type:varchar(100) collate utf8mb4_bin;not null",
+			want: convertedTag{
+				specs:   []string{"notnull"},
+				invalid: []string{"type:varchar(100) collate utf8mb4_bin"},
+			},
+		},
+		{
+			name:    "[Normal] gorm ignore",
+			tagName: GORMTAG,
+			value:   "-",
+			want:    convertedTag{specs: []string{"-"}},
+		},
+		{
+			name:    "[Normal] db column name",
+			tagName: DBTAG,
+			value:   "user_id",
+			want:    convertedTag{specs: []string{"name=user_id"}},
+		},
+		{
+			name:    "[Normal] db ignore",
+			tagName: DBTAG,
+			value:   "-",
+			want:    convertedTag{specs: []string{"-"}},
+		},
+		{
+			name:    "[Normal] bun name, primary key and unique group",
+			tagName: BUNTAG,
+			value:   "id,pk,autoincrement,unique:group_name,notnull",
+			want: convertedTag{
+				specs: []string{"name=id", "auto", "notnull"},
+				constraint: fieldConstraint{
+					primaryKey: true,
+					indexes:    []tagIndex{{name: "group_name", unique: true}},
+				},
+			},
+		},
+		{
+			name:    "[Normal] bun without name",
+			tagName: BUNTAG,
+			value:   ",type:varchar(64),nullzero,default:0,embed:author_",
+			want:    convertedTag{specs: []string{"size=64", "null", "default=0", "embedded", "prefix=author_"}},
+		},
+		{
+			name:    "[Normal] bun column without notnull is null",
+			tagName: BUNTAG,
+			value:   "nickname",
+			want:    convertedTag{specs: []string{"name=nickname", "null"}},
+		},
+		{
+			name:    "[Normal] bun type that has commas in parentheses",
+			tagName: BUNTAG,
+			value:   "price,type:decimal(10,2),notnull",
+			want:    convertedTag{specs: []string{"name=price", "type=decimal(10,2)", "notnull"}},
+		},
+		{
+			name:    "[Normal] bun default that has commas in quotes",
+			tagName: BUNTAG,
+			value:   "label,default:'a, b'",
+			want:    convertedTag{specs: []string{"name=label", "default='a, b'", "null"}},
+		},
+		{
+			name:    "[Normal] bun relation and table",
+			tagName: BUNTAG,
+			value:   "table:users,alias:u,rel:has-one",
+			want: convertedTag{
+				specs:   []string{"-"},
+				ignored: []string{"table:users", "alias:u"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := convertTag(tt.tagName, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("convertTag() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+type GormArticle struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement"`
+	Title     string    `gorm:"type:varchar(100);index:idx_title_author"`
+	AuthorID  uint64    `gorm:"index:idx_title_author" db:"writer_id"`
+	Body      string    `gorm:"type:text;comment:article body"`
+	Slug      string    `ddl:"size=32" gorm:"size:64;uniqueIndex"`
+	Published time.Time `db:"published_on"`
+	Author    *Address  `gorm:"foreignKey:AuthorID"`
+}
+
+func TestDDLMaker_parseWithCompatibleTags(t *testing.T) {
+	dm := DDLMaker{
+		Dialect: mysql.MySQL{},
+		config:  Config{CompatibleTags: []string{GORMTAG, DBTAG}},
+	}
+	if err := dm.AddStruct(GormArticle{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	tbl := dm.Tables[0]
+	var columns []string
+	for _, c := range tbl.Columns() {
+		sql, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		columns = append(columns, sql)
+	}
+	wantColumns := []string{
+		"`id` BIGINT unsigned NOT NULL AUTO_INCREMENT",
+		"`title` VARCHAR(100) NULL",
+		"`writer_id` BIGINT unsigned NULL",
+		"`body` TEXT NULL",
+		"`slug` VARCHAR(32) NULL",
+		"`published_on` DATETIME NOT NULL",
+	}
+	if !reflect.DeepEqual(columns, wantColumns) {
+		t.Errorf("columns = %v, want %v", columns, wantColumns)
+	}
+
+	if got := tbl.PrimaryKey().ToSQL(); got != "PRIMARY KEY (`id`)" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", got)
+	}
+
+	var indexes []string
+	for _, i := range tbl.Indexes() {
+		indexes = append(indexes, i.ToSQL())
+	}
+	wantIndexes := []string{
+		"INDEX `idx_title_author` (`title`, `writer_id`)",
+		"UNIQUE `idx_gorm_article_slug` (`slug`)",
+	}
+	if !reflect.DeepEqual(indexes, wantIndexes) {
+		t.Errorf("indexes = %v, want %v", indexes, wantIndexes)
+	}
+
+	wantIgnored := []IgnoredTagKey{
+		{Struct: "GormArticle", Field: "Body", Tag: GORMTAG, Key: "comment:article body"},
+	}
+	if !reflect.DeepEqual(dm.IgnoredTagKeys, wantIgnored) {
+		t.Errorf("IgnoredTagKeys = %v, want %v", dm.IgnoredTagKeys, wantIgnored)
+	}
+}
+
+type GormPayment struct {
+	ID     uint64  `gorm:"primaryKey"`
+	Amount float64 `gorm:"type:decimal(10,2);not null"`
+	Memo   string  `gorm:"type:varchar(100) collate utf8mb4_bin"`
+}
+
+func TestDDLMaker_parseWithCompatibleTagsStrict(t *testing.T) {
+	dm := DDLMaker{
+		Dialect: mysql.MySQL{},
+		config:  Config{CompatibleTags: []string{GORMTAG}, Strict: true},
+	}
+	if err := dm.AddStruct(GormPayment{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err := dm.parse()
+	var tagErrs TagErrors
+	if !errors.As(err, &tagErrs) {
+		t.Fatalf("parse() does not return TagErrors: %v", err)
+	}
+	if len(tagErrs) != 1 || !errors.Is(tagErrs[0], ErrInvalidTag) {
+		t.Errorf("mismatch want=1 %v, got=%v", ErrInvalidTag, tagErrs)
+	}
+}
@@ -1,14 +1,24 @@
 package ddlmaker
 
+import "github.com/nao1215/ddl-maker/dialect"
+
 // Config set user environment
 type Config struct {
 	OutFilePath string
 	DB          DBConfig
+	// NamingStrategy converts struct and field names to table and column names.
+	// If it's nil, SnakeCase is used.
+	NamingStrategy NamingStrategy
+	// CompatibleTags is the struct tags read when "ddl" tag key is not present.
+	// The former tag takes precedence. e.g. []string{ddlmaker.GORMTAG, ddlmaker.DBTAG}
+	CompatibleTags []string
+	// InferNullability makes the pointer and sql.Null* type columns NULL without "null" tag.
+	// "notnull" tag overrides it.
+	InferNullability bool
+	// Strict rejects the unknown, duplicate and conflicting "ddl" tag keys and the values
+	// that do not parse. All problems of all structs are reported at once by TagErrors.
+	Strict bool
 }
 
 // DBConfig set user db environment
-type DBConfig struct {
-	Driver  string
-	Engine  string
-	Charset string
-}
+type DBConfig = dialect.DBConfig
@@ -17,11 +17,41 @@ const (
 	TAGPREFIX = "ddl"
 	// IGNORETAG using ignore struct field
 	IGNORETAG = "-"
+	// EMBEDDEDTAG promotes the fields of the named nested struct as columns
+	EMBEDDEDTAG = "embedded"
+	// NAMETAG specifies column name. It's used as is.
+	NAMETAG = "name"
+	// PREFIXTAG specifies column name prefix for the fields of the embedded struct
+	PREFIXTAG = "prefix"
+	// ENUMTAG specifies the allowed values of the enum column separated by "|" (e.g. enum=active|inactive)
+	ENUMTAG = "enum"
 )
 
 var (
 	// ErrIgnoreField is Ignore Field Error
 	ErrIgnoreField = errors.New("error ignore this field")
+	// ErrDuplicateColumn is the error that column names collide
+	ErrDuplicateColumn = errors.New("column name is duplicated")
+	// ErrScaleExceedsPrecision is the error that scale is greater than precision
+	ErrScaleExceedsPrecision = errors.New("scale must not be greater than precision")
+	// ErrRecursiveEmbedding is the error that the struct embeds itself
+	ErrRecursiveEmbedding = errors.New("struct is embedded recursively")
+	// ErrInvalidEnumType is the error that the enum column is not string type
+	ErrInvalidEnumType = errors.New("enum column must be string type")
+	// ErrInvalidTag is the error that "ddl" tag is malformed
+	ErrInvalidTag = errors.New("invalid ddl tag")
+	// ErrUnknownTagKey is the error that "ddl" tag has the unknown key in strict mode
+	ErrUnknownTagKey = errors.New("unknown ddl tag key")
+	// ErrDuplicateTagKey is the error that "ddl" tag has the same key twice in strict mode
+	ErrDuplicateTagKey = errors.New("duplicate ddl tag key")
+	// ErrConflictingTagKeys is the error that "ddl" tag has the keys that can not be specified together in strict mode
+	ErrConflictingTagKeys = errors.New("conflicting ddl tag keys")
+	// ErrConflictingConstraint is the error that the constraints declared by the methods and the struct tags differ
+	ErrConflictingConstraint = errors.New("constraint is declared differently by method and tag")
+	// ErrUnknownColumn is the error that the constraint references the column that the table does not have
+	ErrUnknownColumn = errors.New("constraint references unknown column")
+	// ErrInvalidDefault is the error that the default value does not match the column type
+	ErrInvalidDefault = errors.New("invalid default value")
 )
 
 // DDLMaker is the model for generating DDL from golang structures.
@@ -35,13 +65,19 @@ type DDLMaker struct {
 	Structs []interface{}
 	// Tables is interface to generate tables for each DB (e.g. MySQL, PostgreSQL)
 	Tables []dialect.Table
+	// IgnoredTagKeys is the keys of the compatible tags (e.g. gorm) that are not mapped to "ddl" tag
+	IgnoredTagKeys []IgnoredTagKey
+	// types is the SQL types of the Go types registered by RegisterType
+	types map[reflect.Type]string
+	// Warnings is the lint warnings of the struct fields (e.g. non-pointer field tagged "null")
+	Warnings []string
 }
 
 // New creates a DDLMaker and returns it.
 func New(conf Config) (*DDLMaker, error) {
-	d, err := dialect.New(conf.DB.Driver, conf.DB.Engine, conf.DB.Charset)
+	d, err := dialect.NewWithConfig(conf.DB)
 	if err != nil {
-		return nil, fmt.Errorf("error dialect.New(): %w", err)
+		return nil, fmt.Errorf("error dialect.NewWithConfig(): %w", err)
 	}
 
 	return &DDLMaker{
@@ -50,6 +86,35 @@ func New(conf Config) (*DDLMaker, error) {
 	}, nil
 }
 
+// namingStrategy return NamingStrategy specified by Config. Default is SnakeCase.
+func (dm *DDLMaker) namingStrategy() NamingStrategy {
+	if dm.config.NamingStrategy == nil {
+		return SnakeCase{}
+	}
+	return dm.config.NamingStrategy
+}
+
+// RegisterType maps the Go type to the SQL type. It takes precedence over the types
+// registered for the driver by dialect.RegisterType. The pointer type is mapped as
+// same as the element type.
+func (dm *DDLMaker) RegisterType(rt reflect.Type, sqlType string) {
+	if dm.types == nil {
+		dm.types = make(map[reflect.Type]string)
+	}
+	dm.types[rt] = sqlType
+}
+
+// lookupType return the SQL type of the Go type registered by RegisterType or dialect.RegisterType.
+func (dm *DDLMaker) lookupType(rt reflect.Type) (string, bool) {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if sqlType, ok := dm.types[rt]; ok {
+		return sqlType, true
+	}
+	return dialect.LookupType(dm.config.DB.Driver, rt)
+}
+
 // AddStruct add the structure to be converted in DDLMaker.
 func (dm *DDLMaker) AddStruct(ss ...interface{}) error {
 	pkgs := make(map[string]bool)
@@ -81,6 +146,12 @@ func (dm *DDLMaker) Generate() error {
 	if err != nil {
 		return err // This pass will not go through.
 	}
+	for _, key := range dm.IgnoredTagKeys {
+		log.Println(key)
+	}
+	for _, warning := range dm.Warnings {
+		log.Printf("warning: %s\n", warning)
+	}
 
 	file, err := os.Create(dm.config.OutFilePath)
 	if err != nil {
@@ -98,6 +169,13 @@ func (dm *DDLMaker) Generate() error {
 	return nil
 }
 
+// dropTemplater is for type assertion. A dialect implements it when the tables must be
+// dropped before any table is created (e.g. the interleaved child table of Spanner must be
+// dropped before the parent table). The template is executed for the tables in reverse order.
+type dropTemplater interface {
+	DropTemplate() string
+}
+
 // generate is helper method that generate ddl file
 func (dm *DDLMaker) generate(w io.Writer) error {
 	header, err := template.New("header").Parse(dm.Dialect.HeaderTemplate())
@@ -118,6 +196,17 @@ func (dm *DDLMaker) generate(w io.Writer) error {
 	if err := header.Execute(w, nil); err != nil {
 		return fmt.Errorf("template header execute error: %w", err)
 	}
+	if d, ok := dm.Dialect.(dropTemplater); ok {
+		drop, err := template.New("drop").Parse(d.DropTemplate())
+		if err != nil {
+			return fmt.Errorf("error parse drop template: %w", err)
+		}
+		for i := len(dm.Tables) - 1; i >= 0; i-- {
+			if err := drop.Execute(w, dm.Tables[i]); err != nil {
+				return fmt.Errorf("template drop execute error: %w", err)
+			}
+		}
+	}
 	for _, table := range dm.Tables {
 		err := tmpl.Execute(w, table)
 		if err != nil {
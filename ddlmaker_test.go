@@ -5,15 +5,28 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"math/big"
+	"net/netip"
 	"os"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/nao1215/ddl-maker/dialect"
+	"github.com/nao1215/ddl-maker/dialect/clickhouse"
+	"github.com/nao1215/ddl-maker/dialect/cockroach"
+	"github.com/nao1215/ddl-maker/dialect/duckdb"
+	"github.com/nao1215/ddl-maker/dialect/mariadb"
 	"github.com/nao1215/ddl-maker/dialect/mock"
+	"github.com/nao1215/ddl-maker/dialect/mssql"
 	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/oracle"
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/spanner"
 	"github.com/nao1215/ddl-maker/dialect/sqlite"
+	"github.com/nao1215/ddl-maker/schema"
+	"github.com/nao1215/ddl-maker/testdata/uuid"
 )
 
 type TestOne struct {
@@ -66,6 +79,20 @@ func TestNew(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	dialect.Register("mock", func(conf DBConfig) (dialect.Dialect, error) {
+		return mock.SQLMock{Engine: conf.Engine, Charset: conf.Charset}, nil
+	})
+	conf = Config{
+		DB: DBConfig{Driver: "mock", Engine: "dummy", Charset: "dummy"},
+	}
+	dm, err := New(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(dm.Dialect, mock.SQLMock{Engine: "dummy", Charset: "dummy"}) {
+		t.Fatalf("registered dialect is not used: %v", dm.Dialect)
+	}
 }
 
 func TestAddStruct(t *testing.T) {
@@ -329,7 +356,7 @@ func TestGenerate2(t *testing.T) {
 		if got == nil {
 			t.Fatal("template execute error did not occure")
 		}
-		if !errors.As(got, &want) {
+		if !errors.Is(got, want) {
 			t.Errorf("mismatch want:%v, got:%v", want, got)
 		}
 	})
@@ -497,3 +524,913 @@ func TestDDLMaker_GenerateForSQLite(t *testing.T) {
 		}
 	})
 }
+
+type Account struct {
+	ID        int64  `ddl:"auto"`
+	Name      string `ddl:"size=64"`
+	Active    bool   `ddl:"default=true"`
+	Avatar    []byte `ddl:"null"`
+	CreatedAt time.Time
+}
+
+func (a Account) PrimaryKey() dialect.PrimaryKey {
+	return postgres.AddPrimaryKey("id")
+}
+
+func (a Account) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		postgres.AddUniqueIndex("name_uniq_idx", "account", "name"),
+	}
+}
+
+type Post struct {
+	ID          int64 `ddl:"auto"`
+	AccountID   int64
+	Body        string     `ddl:"type=text"`
+	PublishedAt *time.Time `ddl:"null"`
+	CreatedAt   time.Time
+}
+
+func (p Post) PrimaryKey() dialect.PrimaryKey {
+	return postgres.AddPrimaryKey("id")
+}
+
+func (p Post) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		postgres.AddIndex("account_id_created_at_idx", "post", "account_id", "created_at"),
+	}
+}
+
+func (p Post) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		postgres.AddForeignKey(
+			[]string{"account_id"},
+			[]string{"id"},
+			"account",
+			postgres.WithDeleteForeignKeyOption(postgres.ForeignKeyOptionCascade),
+		),
+	}
+}
+
+func TestDDLMaker_GenerateForPostgreSQL(t *testing.T) {
+	t.Run("[Normal] generate ddl file for PostgreSQL", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/postgres/test.sql",
+			DB: DBConfig{
+				Driver: "postgres",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/postgres/test.sql")
+
+		if err = dm.AddStruct(&Account{}, &Post{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/postgres/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/postgres/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+}
+
+type Invoice struct {
+	ID         int64 `ddl:"auto"`
+	CustomerID int64
+	Amount     float64
+	Paid       bool   `ddl:"default=0"`
+	Note       string `ddl:"null,type=text"`
+	IssuedAt   time.Time
+}
+
+func (i Invoice) PrimaryKey() dialect.PrimaryKey {
+	return mssql.AddPrimaryKey("id")
+}
+
+func (i Invoice) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		mssql.AddIndex("customer_id_idx", "invoice", "customer_id"),
+		mssql.AddUniqueIndex("customer_id_issued_at_uniq_idx", "invoice", "customer_id", "issued_at"),
+	}
+}
+
+func (i Invoice) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		mssql.AddForeignKey(
+			[]string{"customer_id"},
+			[]string{"id"},
+			"customer",
+			mssql.WithDeleteForeignKeyOption(mssql.ForeignKeyOptionCascade),
+		),
+	}
+}
+
+func TestDDLMaker_GenerateForSQLServer(t *testing.T) {
+	t.Run("[Normal] generate ddl file for SQL Server", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/mssql/test.sql",
+			DB: DBConfig{
+				Driver: "sqlserver",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/mssql/test.sql")
+
+		if err = dm.AddStruct(&Invoice{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/mssql/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/mssql/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+}
+
+type Department struct {
+	ID        int64  `ddl:"auto"`
+	Name      string `ddl:"size=100"`
+	Budget    float64
+	Active    bool `ddl:"default=1"`
+	CreatedAt time.Time
+}
+
+func (d Department) PrimaryKey() dialect.PrimaryKey {
+	return oracle.AddPrimaryKey("id")
+}
+
+func (d Department) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		oracle.AddUniqueIndex("department_name_uniq_idx", "department", "name"),
+	}
+}
+
+type Employee struct {
+	ID           int64 `ddl:"auto"`
+	DepartmentID int64 `ddl:"null"`
+	Name         string
+	Resume       string `ddl:"null,type=text"`
+	HiredAt      time.Time
+}
+
+func (e Employee) PrimaryKey() dialect.PrimaryKey {
+	return oracle.AddPrimaryKey("id")
+}
+
+func (e Employee) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		oracle.AddForeignKey(
+			[]string{"department_id"},
+			[]string{"id"},
+			"department",
+			oracle.WithDeleteForeignKeyOption(oracle.ForeignKeyOptionSetNull),
+		),
+	}
+}
+
+func TestDDLMaker_GenerateForOracle(t *testing.T) {
+	t.Run("[Normal] generate ddl file for Oracle", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/oracle/test.sql",
+			DB: DBConfig{
+				Driver: "oracle",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/oracle/test.sql")
+
+		if err = dm.AddStruct(&Department{}, &Employee{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/oracle/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/oracle/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+
+	t.Run("[Error] identifier is too long before 12.2", func(t *testing.T) {
+		dm, err := New(Config{
+			DB: DBConfig{
+				Driver:  "oracle",
+				Version: "12.1",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+
+		if err = dm.AddStruct(&Employee{}, &TooLongIdentifierForOracleDatabase{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		got := dm.parse()
+		if !errors.Is(got, oracle.ErrIdentifierTooLong) {
+			t.Errorf("mismatch want:%v, got:%v", oracle.ErrIdentifierTooLong, got)
+		}
+	})
+
+	t.Run("[Normal] identifier is not too long from 12.2", func(t *testing.T) {
+		dm, err := New(Config{
+			DB: DBConfig{
+				Driver:  "oracle",
+				Version: "12.2",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+
+		if err = dm.AddStruct(&Employee{}, &TooLongIdentifierForOracleDatabase{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err := dm.parse(); err != nil {
+			t.Error(err)
+		}
+	})
+}
+
+type TooLongIdentifierForOracleDatabase struct {
+	ID int64
+}
+
+type PageView struct {
+	ID        uint64
+	UserID    *uint64
+	Path      string
+	Country   string  `ddl:"type=lowcardinality"`
+	Referrer  string  `ddl:"null"`
+	Duration  float32 `ddl:"default=0"`
+	CreatedAt time.Time
+}
+
+func (pv PageView) PrimaryKey() dialect.PrimaryKey {
+	return clickhouse.AddPrimaryKey("created_at", "id").WithPartitionBy("toYYYYMM(created_at)")
+}
+
+func (pv PageView) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		clickhouse.AddIndex("path_idx", "path").WithType("bloom_filter").WithGranularity(4),
+		clickhouse.AddIndex("duration_idx", "duration"),
+	}
+}
+
+func (pv PageView) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		mysql.AddForeignKey([]string{"user_id"}, []string{"id"}, "user"),
+	}
+}
+
+type Metric struct {
+	Name  string
+	Value float64
+}
+
+func TestDDLMaker_GenerateForClickHouse(t *testing.T) {
+	t.Run("[Normal] generate ddl file for ClickHouse", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/clickhouse/test.sql",
+			DB: DBConfig{
+				Driver: "clickhouse",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/clickhouse/test.sql")
+
+		if err = dm.AddStruct(&PageView{}, &Metric{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/clickhouse/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/clickhouse/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+}
+
+type Sale struct {
+	ID       int64 `ddl:"auto"`
+	StoreID  uint32
+	Total    *big.Int
+	Tags     []string
+	Payload  []byte `ddl:"null"`
+	SoldAt   time.Time
+	Refunded bool `ddl:"default=false"`
+}
+
+func (s Sale) PrimaryKey() dialect.PrimaryKey {
+	return duckdb.AddPrimaryKey("id")
+}
+
+func (s Sale) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		duckdb.AddIndex("store_id_sold_at_idx", "sale", "store_id", "sold_at"),
+	}
+}
+
+func (s Sale) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		duckdb.AddForeignKey([]string{"store_id"}, []string{"id"}, "store"),
+	}
+}
+
+func TestDDLMaker_GenerateForDuckDB(t *testing.T) {
+	t.Run("[Normal] generate ddl file for DuckDB", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/duckdb/test.sql",
+			DB: DBConfig{
+				Driver: "duckdb",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/duckdb/test.sql")
+
+		if err = dm.AddStruct(&Sale{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/duckdb/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/duckdb/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+}
+
+type Singer struct {
+	SingerID  string `ddl:"size=36"`
+	Email     string
+	Nicknames []string  `ddl:"null"`
+	Profile   []byte    `ddl:"null,type=json"`
+	CreatedAt time.Time `ddl:"default=CURRENT_TIMESTAMP()"`
+}
+
+func (s Singer) PrimaryKey() dialect.PrimaryKey {
+	return spanner.AddPrimaryKey("singer_id")
+}
+
+func (s Singer) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		spanner.AddUniqueIndex("singer_email_uniq_idx", "singer", "email"),
+	}
+}
+
+type Album struct {
+	SingerID string `ddl:"size=36"`
+	AlbumID  int64
+	LabelID  *int64 `ddl:"null"`
+	Title    string `ddl:"size=1024"`
+	Cover    []byte `ddl:"null"`
+}
+
+func (a Album) PrimaryKey() dialect.PrimaryKey {
+	return spanner.AddPrimaryKey("singer_id", "album_id").
+		WithInterleaveInParent("singer", spanner.ForeignKeyOptionCascade)
+}
+
+func (a Album) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		spanner.AddForeignKey([]string{"label_id"}, []string{"id"}, "label"),
+	}
+}
+
+// Song is interleaved in Album that is interleaved in Singer
+type Song struct {
+	SingerID string `ddl:"size=36"`
+	AlbumID  int64
+	TrackID  int64
+	Title    string `ddl:"size=1024"`
+}
+
+func (s Song) PrimaryKey() dialect.PrimaryKey {
+	return spanner.AddPrimaryKey("singer_id", "album_id", "track_id").
+		WithInterleaveInParent("album", spanner.ForeignKeyOptionCascade)
+}
+
+type AutoIncrementForSpanner struct {
+	ID int64 `ddl:"auto"`
+}
+
+func TestDDLMaker_GenerateForSpanner(t *testing.T) {
+	t.Run("[Normal] generate ddl file for Spanner", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/spanner/test.sql",
+			DB: DBConfig{
+				Driver: "spanner",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/spanner/test.sql")
+
+		if err = dm.AddStruct(&Singer{}, &Album{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/spanner/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/spanner/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+
+	t.Run("[Normal] interleaved tables are dropped child first", func(t *testing.T) {
+		dm, err := New(Config{
+			DB: DBConfig{
+				Driver: "spanner",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+
+		if err = dm.AddStruct(&Singer{}, &Album{}, &Song{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.parse(); err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		if err = dm.generate(&buf); err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/spanner/interleave.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), buf.String()); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+
+	t.Run("[Error] auto-increment is not supported", func(t *testing.T) {
+		dm, err := New(Config{
+			DB: DBConfig{
+				Driver: "spanner",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+
+		if err = dm.AddStruct(&AutoIncrementForSpanner{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.parse(); err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		got := dm.generate(&buf)
+		if !errors.Is(got, spanner.ErrAutoIncrementNotSupported) {
+			t.Errorf("mismatch want:%v, got:%v", spanner.ErrAutoIncrementNotSupported, got)
+		}
+	})
+}
+
+type Ticket struct {
+	ID        string `ddl:"type=uuid"`
+	Number    int64  `ddl:"sequence"`
+	ClientIP  string `ddl:"type=inet6"`
+	Detail    []byte `ddl:"null,type=json"`
+	Memo      string `ddl:"null,invisible"`
+	CreatedAt time.Time
+}
+
+func (t Ticket) PrimaryKey() dialect.PrimaryKey {
+	return mysql.AddPrimaryKey("id")
+}
+
+func (t Ticket) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		mysql.AddUniqueIndex("number_uniq_idx", "number"),
+	}
+}
+
+// Device has uuid.UUID that has the same package and type name as github.com/google/uuid
+type Device struct {
+	ID      uuid.UUID `ddl:"pk"`
+	Address netip.Addr
+}
+
+func TestDDLMaker_GenerateForMariaDB(t *testing.T) {
+	t.Run("[Normal] generate ddl file for MariaDB", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/mariadb/test.sql",
+			DB: DBConfig{
+				Driver:  "mariadb",
+				Engine:  "InnoDB",
+				Charset: "utf8mb4",
+				Version: "10.11",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/mariadb/test.sql")
+
+		if err = dm.AddStruct(&Ticket{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/mariadb/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/mariadb/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+
+	t.Run("[Normal] uuid.UUID and netip.Addr are native types from 10.7", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/mariadb/test.sql",
+			DB: DBConfig{
+				Driver:  "mariadb",
+				Engine:  "InnoDB",
+				Charset: "utf8mb4",
+				Version: "10.7",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/mariadb/test.sql")
+
+		if err = dm.AddStruct(&Device{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/mariadb/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/mariadb/native.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+
+	t.Run("[Error] sequence is not supported by old version", func(t *testing.T) {
+		dm, err := New(Config{
+			DB: DBConfig{
+				Driver:  "mariadb",
+				Engine:  "InnoDB",
+				Charset: "utf8mb4",
+				Version: "10.2",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+
+		if err = dm.AddStruct(&Ticket{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+		if err = dm.parse(); err != nil {
+			t.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+		got := dm.generate(&buf)
+		if !errors.Is(got, mariadb.ErrNotSupportedVersion) {
+			t.Errorf("mismatch want:%v, got:%v", mariadb.ErrNotSupportedVersion, got)
+		}
+	})
+}
+
+type Event struct {
+	ID        string `ddl:"auto,type=uuid"`
+	Seq       int64  `ddl:"auto"`
+	AccountID int64
+	Kind      string    `ddl:"size=32"`
+	Labels    []string  `ddl:"null"`
+	Payload   []byte    `ddl:"null,type=json"`
+	CreatedAt time.Time `ddl:"default=now()"`
+}
+
+func (e Event) PrimaryKey() dialect.PrimaryKey {
+	return cockroach.AddPrimaryKey("id")
+}
+
+func (e Event) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		cockroach.AddIndex("event_created_at_idx", "event", "created_at").
+			WithHashSharded(8).WithStoring("kind", "payload"),
+		cockroach.AddUniqueIndex("event_seq_uniq_idx", "event", "seq"),
+	}
+}
+
+func (e Event) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		postgres.AddForeignKey(
+			[]string{"account_id"},
+			[]string{"id"},
+			"account",
+			postgres.WithDeleteForeignKeyOption(postgres.ForeignKeyOptionCascade),
+		),
+	}
+}
+
+func TestDDLMaker_GenerateForCockroachDB(t *testing.T) {
+	t.Run("[Normal] generate ddl file for CockroachDB", func(t *testing.T) {
+		dm, err := New(Config{
+			OutFilePath: "./testdata/cockroach/test.sql",
+			DB: DBConfig{
+				Driver: "cockroach",
+			},
+		})
+		if err != nil {
+			t.Fatal("error new maker", err)
+		}
+		defer os.Remove("./testdata/cockroach/test.sql")
+
+		if err = dm.AddStruct(&Event{}); err != nil {
+			t.Fatal("error add struct", err)
+		}
+
+		if err = dm.Generate(); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := os.ReadFile("./testdata/cockroach/test.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want, err := os.ReadFile("./testdata/cockroach/golden.sql")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if diff := cmp.Diff(string(want), string(got)); diff != "" {
+			t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+		}
+	})
+}
+
+type Order struct {
+	ID         int64
+	CustomerID int64
+	Code       string `ddl:"size=32"`
+	Note       string `ddl:"null"`
+	CreatedAt  time.Time
+}
+
+func (o Order) PrimaryKey() dialect.PrimaryKey {
+	return schema.AddPrimaryKey("id")
+}
+
+func (o Order) Indexes() dialect.Indexes {
+	return dialect.Indexes{
+		schema.AddIndex("created_at_idx", "created_at"),
+		schema.AddUniqueIndex("code_uniq_idx", "code"),
+	}
+}
+
+func (o Order) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{
+		schema.AddForeignKey(
+			[]string{"customer_id"},
+			[]string{"id"},
+			"customer",
+			schema.OnUpdate(schema.Cascade),
+			schema.OnDelete(schema.SetNull),
+		),
+	}
+}
+
+func TestDDLMaker_GenerateWithDialectAgnosticConstraints(t *testing.T) {
+	confs := []DBConfig{
+		{Driver: "mysql", Engine: "InnoDB", Charset: "utf8mb4"},
+		{Driver: "mariadb", Engine: "InnoDB", Charset: "utf8mb4"},
+		{Driver: "sqlite"},
+		{Driver: "postgres"},
+		{Driver: "cockroach"},
+		{Driver: "mssql"},
+		{Driver: "oracle"},
+		{Driver: "duckdb"},
+		{Driver: "clickhouse"},
+		{Driver: "spanner"},
+	}
+	for _, conf := range confs {
+		conf := conf
+		t.Run("[Normal] generate ddl file for "+conf.Driver, func(t *testing.T) {
+			outFilePath := fmt.Sprintf("./testdata/%s/schema_test.sql", conf.Driver)
+			dm, err := New(Config{
+				OutFilePath: outFilePath,
+				DB:          conf,
+			})
+			if err != nil {
+				t.Fatal("error new maker", err)
+			}
+			defer os.Remove(outFilePath)
+
+			if err = dm.AddStruct(&Order{}); err != nil {
+				t.Fatal("error add struct", err)
+			}
+
+			if err = dm.Generate(); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(outFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := os.ReadFile(fmt.Sprintf("./testdata/%s/schema.sql", conf.Driver))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(want), string(got)); diff != "" {
+				t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+			}
+		})
+	}
+}
+
+// Journal has the table comment and the column comments
+type Journal struct {
+	ID    int64   `ddl:"comment=Journal ID"`
+	Title string  `ddl:"comment='It''s the title, not the body'"`
+	Body  *string `ddl:"null"`
+}
+
+func (Journal) PrimaryKey() dialect.PrimaryKey {
+	return schema.AddPrimaryKey("id")
+}
+
+func (Journal) TableComment() string {
+	return `User's journals (C:\journals)`
+}
+
+func TestDDLMaker_GenerateWithComment(t *testing.T) {
+	testGenerateForAllDrivers(t, "comment", Config{}, &Journal{})
+}
+
+// testGenerateForAllDrivers generates ddl of the structs for all drivers, and
+// compares it with ./testdata/<driver>/<golden>.sql. conf.OutFilePath and conf.DB are overwritten.
+func testGenerateForAllDrivers(t *testing.T, golden string, conf Config, structs ...interface{}) {
+	t.Helper()
+
+	dbConfs := []DBConfig{
+		{Driver: "mysql", Engine: "InnoDB", Charset: "utf8mb4"},
+		{Driver: "mariadb", Engine: "InnoDB", Charset: "utf8mb4"},
+		{Driver: "sqlite"},
+		{Driver: "postgres"},
+		{Driver: "cockroach"},
+		{Driver: "mssql"},
+		{Driver: "oracle"},
+		{Driver: "duckdb"},
+		{Driver: "clickhouse"},
+		{Driver: "spanner"},
+	}
+	for _, dbConf := range dbConfs {
+		conf := conf
+		conf.DB = dbConf
+		conf.OutFilePath = fmt.Sprintf("./testdata/%s/%s_test.sql", dbConf.Driver, golden)
+		t.Run("[Normal] generate ddl file for "+dbConf.Driver, func(t *testing.T) {
+			dm, err := New(conf)
+			if err != nil {
+				t.Fatal("error new maker", err)
+			}
+			defer os.Remove(conf.OutFilePath)
+
+			if err = dm.AddStruct(structs...); err != nil {
+				t.Fatal("error add struct", err)
+			}
+
+			if err = dm.Generate(); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := os.ReadFile(conf.OutFilePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			want, err := os.ReadFile(fmt.Sprintf("./testdata/%s/%s.sql", dbConf.Driver, golden))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(string(want), string(got)); diff != "" {
+				t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package dialect
+
+import (
+	"github.com/nao1215/ddl-maker/dialect/clickhouse"
+	"github.com/nao1215/ddl-maker/dialect/cockroach"
+	"github.com/nao1215/ddl-maker/dialect/duckdb"
+	"github.com/nao1215/ddl-maker/dialect/mariadb"
+	"github.com/nao1215/ddl-maker/dialect/mssql"
+	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/oracle"
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/spanner"
+	"github.com/nao1215/ddl-maker/dialect/sqlite"
+)
+
+// init registers the built-in dialects.
+func init() {
+	Register("mysql", func(conf DBConfig) (Dialect, error) {
+		return &mysql.MySQL{
+			Engine:  conf.Engine,
+			Charset: conf.Charset,
+		}, nil
+	})
+	Register("mariadb", func(conf DBConfig) (Dialect, error) {
+		v, err := mariadb.ParseVersion(conf.Version)
+		if err != nil {
+			return nil, err
+		}
+		return &mariadb.MariaDB{
+			MySQL: mysql.MySQL{
+				Engine:  conf.Engine,
+				Charset: conf.Charset,
+			},
+			Version: v,
+		}, nil
+	})
+	Register("sqlite", func(conf DBConfig) (Dialect, error) {
+		return &sqlite.SQLite{}, nil
+	})
+	Register("postgres", func(conf DBConfig) (Dialect, error) {
+		v, err := postgres.ParseMajorVersion(conf.Version)
+		if err != nil {
+			return nil, err
+		}
+		return &postgres.PostgreSQL{MajorVersion: v}, nil
+	})
+	Register("cockroach", func(conf DBConfig) (Dialect, error) {
+		return &cockroach.CockroachDB{}, nil
+	})
+	mssqlFactory := func(conf DBConfig) (Dialect, error) {
+		return &mssql.SQLServer{}, nil
+	}
+	Register("mssql", mssqlFactory)
+	Register("sqlserver", mssqlFactory)
+	Register("oracle", func(conf DBConfig) (Dialect, error) {
+		max, err := oracle.MaxIdentifierLengthOf(conf.Version)
+		if err != nil {
+			return nil, err
+		}
+		return &oracle.Oracle{MaxIdentifierLength: max}, nil
+	})
+	Register("duckdb", func(conf DBConfig) (Dialect, error) {
+		return &duckdb.DuckDB{}, nil
+	})
+	Register("clickhouse", func(conf DBConfig) (Dialect, error) {
+		return &clickhouse.ClickHouse{
+			Engine: conf.Engine,
+		}, nil
+	})
+	Register("spanner", func(conf DBConfig) (Dialect, error) {
+		return &spanner.Spanner{}, nil
+	})
+
+	for driver, mapping := range builtinTypes {
+		for typeName, sqlType := range mapping {
+			RegisterTypeName(driver, typeName, sqlType)
+		}
+	}
+}
+
+const (
+	googleUUID   = "github.com/google/uuid.UUID"
+	gofrsUUID    = "github.com/gofrs/uuid.UUID"
+	gofrsUUIDv5  = "github.com/gofrs/uuid/v5.UUID"
+	netipAddr    = "net/netip.Addr"
+	pgtypePrefix = "github.com/jackc/pgx/v5/pgtype."
+)
+
+// builtinTypes is the SQL types of the common ecosystem types for the built-in dialects.
+// MariaDB is not listed because it converts UUID and netip.Addr by the server version.
+var builtinTypes = map[string]map[string]string{
+	"mysql":      commonTypes("CHAR(36)", "VARCHAR(45)"),
+	"sqlite":     commonTypes("TEXT", "TEXT"),
+	"postgres":   withPgtype(commonTypes("UUID", "INET")),
+	"cockroach":  withPgtype(commonTypes("UUID", "INET")),
+	"mssql":      commonTypes("UNIQUEIDENTIFIER", "VARCHAR(45)"),
+	"sqlserver":  commonTypes("UNIQUEIDENTIFIER", "VARCHAR(45)"),
+	"oracle":     commonTypes("VARCHAR2(36)", "VARCHAR2(45)"),
+	"duckdb":     commonTypes("UUID", "VARCHAR"),
+	"clickhouse": commonTypes("UUID", "IPv6"),
+	"spanner":    commonTypes("STRING(36)", "STRING(45)"),
+}
+
+// commonTypes return the SQL types of UUID (google/uuid, gofrs/uuid) and netip.Addr.
+// decimal.Decimal (shopspring/decimal) is converted by the dialects with precision and scale.
+func commonTypes(uuid, addr string) map[string]string {
+	return map[string]string{
+		googleUUID:  uuid,
+		gofrsUUID:   uuid,
+		gofrsUUIDv5: uuid,
+		netipAddr:   addr,
+	}
+}
+
+// withPgtype adds the SQL types of pgtype (github.com/jackc/pgx/v5/pgtype) to mapping.
+func withPgtype(mapping map[string]string) map[string]string {
+	pgtypes := map[string]string{
+		"Bool":        "BOOLEAN",
+		"Int2":        "SMALLINT",
+		"Int4":        "INTEGER",
+		"Int8":        "BIGINT",
+		"Float4":      "REAL",
+		"Float8":      "DOUBLE PRECISION",
+		"Numeric":     "NUMERIC",
+		"Text":        "TEXT",
+		"UUID":        "UUID",
+		"Date":        "DATE",
+		"Timestamp":   "TIMESTAMP",
+		"Timestamptz": "TIMESTAMPTZ",
+		"Interval":    "INTERVAL",
+	}
+	for name, sqlType := range pgtypes {
+		mapping[pgtypePrefix+name] = sqlType
+	}
+	return mapping
+}
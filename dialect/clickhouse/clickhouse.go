@@ -0,0 +1,370 @@
+package clickhouse
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrAutoIncrementNotSupported means "auto" tag is specified, but ClickHouse has no auto-increment column.
+	ErrAutoIncrementNotSupported = errors.New("ClickHouse does not support auto-increment column (use generateUUIDv4() or the key of the source table instead)")
+)
+
+const (
+	defaultEngine            = "MergeTree()"
+	defaultDateTimePrecision = 3
+	defaultIndexType         = "minmax"
+	defaultGranularity       = 1
+)
+
+// nullTypes is the mapping of sql.Null* types to the type they wrap.
+var nullTypes = map[string]string{
+	"sql.NullByte":    "uint8",
+	"sql.NullInt16":   "int16",
+	"sql.NullInt32":   "int32",
+	"sql.NullInt64":   "int64",
+	"sql.NullFloat64": "float64",
+	"sql.NullString":  "string",
+	"sql.NullBool":    "bool",
+	"sql.NullTime":    "time.Time",
+}
+
+// ClickHouse is a model with table engine for ClickHouse
+type ClickHouse struct {
+	// Engine is table engine. If it is empty, MergeTree() is used.
+	Engine string
+}
+
+// HeaderTemplate return string that is sql header template
+func (ch ClickHouse) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (ch ClickHouse) FooterTemplate() string {
+	return ""
+}
+
+// TableTemplate return string that is sql table template.
+// ClickHouse has no foreign keys, so they are skipped with a warning.
+// PrimaryKey is used as the sorting key (ORDER BY) of the table engine.
+func (ch ClickHouse) TableTemplate() string {
+	return `{{ .Dialect.SkipForeignKeys .Name (len .ForeignKeys) }}
+DROP TABLE IF EXISTS {{ .Name }};
+
+CREATE TABLE {{ .Name }} (
+    {{ range $i, $c := .Columns -}}
+        {{ if $i }},
+    {{ end }}{{ $c.ToSQL }}
+    {{- end }}
+    {{- range .Indexes.Sort -}},
+    {{ .ToSQL }}
+    {{- end }}
+    {{- range .Checks }},
+    {{ .ToSQL }}
+    {{- end }}
+) ENGINE = {{ .Dialect.TableEngine }}
+{{ if .PrimaryKey }}{{ .PrimaryKey.ToSQL }}{{ else }}ORDER BY tuple(){{ end }}
+{{- if .Comment }}
+{{ .Dialect.CommentSQL .Comment }}{{ end }};
+
+`
+}
+
+// TableEngine return table engine. Default is MergeTree().
+func (ch ClickHouse) TableEngine() string {
+	if ch.Engine == "" {
+		return defaultEngine
+	}
+	return ch.Engine
+}
+
+// SkipForeignKeys logs warning if the table has foreign keys, because ClickHouse
+// does not support foreign keys. It always returns empty string.
+func (ch ClickHouse) SkipForeignKeys(table string, n int) string {
+	if n > 0 {
+		log.Printf("warning: ClickHouse does not support foreign keys. skip %d foreign key(s) of %s\n", n, table)
+	}
+	return ""
+}
+
+// ToSQL convert clickhouse sql string from typeName and type options (e.g. size).
+// Pointer types and sql.Null* types are converted to Nullable(T).
+// The enum column is converted to Enum8 (or Enum16 if the values are more than 127).
+func (ch ClickHouse) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if strings.HasPrefix(typeName, "*") {
+		return ch.nullable(strings.TrimPrefix(typeName, "*"), opt)
+	}
+	if t, ok := nullTypes[typeName]; ok {
+		return ch.nullable(t, opt)
+	}
+	if opt.IsEnum() {
+		return ch.enum(opt.Enum), nil
+	}
+
+	switch typeName {
+	case "int8":
+		return "Int8", nil
+	case "int16":
+		return "Int16", nil
+	case "int32":
+		return "Int32", nil
+	case "int64":
+		return "Int64", nil
+	case "uint8":
+		return "UInt8", nil
+	case "uint16":
+		return "UInt16", nil
+	case "uint32":
+		return "UInt32", nil
+	case "uint64":
+		return "UInt64", nil
+	case "float32":
+		return "Float32", nil
+	case "float64":
+		return "Float64", nil
+	case "decimal", "decimal.Decimal", "big.Rat":
+		return decimal(opt), nil
+	case "string", "[]uint8", "sql.RawBytes":
+		return "String", nil
+	case "lowcardinality":
+		return "LowCardinality(String)", nil
+	case "bool":
+		return "Bool", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "String", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "String", nil
+	case "time.Time":
+		return datetime64(opt.Size), nil
+	case "date":
+		return "Date", nil
+	case "json.RawMessage":
+		return "String", nil
+	case "uuid":
+		return "UUID", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// nullable return Nullable(T) that T is sql string of typeName.
+func (ch ClickHouse) nullable(typeName string, opt sqltype.Option) (string, error) {
+	sql, err := ch.ToSQL(typeName, opt)
+	if err != nil {
+		return "", err
+	}
+	if strings.HasPrefix(sql, "Nullable(") {
+		return sql, nil
+	}
+	return fmt.Sprintf("Nullable(%s)", sql), nil
+}
+
+// CommentSQL return COMMENT clause of the table and the column.
+func (ch ClickHouse) CommentSQL(comment string) string {
+	return "COMMENT " + ch.StringLiteral(comment)
+}
+
+// StringLiteral return the string literal of s.
+// Backslash is the escape character in ClickHouse string literal, so it is escaped too.
+func (ch ClickHouse) StringLiteral(s string) string {
+	return query.SingleQuote(strings.ReplaceAll(s, `\`, `\\`))
+}
+
+// BoolLiteral return true or false. The boolean literals of ClickHouse are lower case.
+func (ch ClickHouse) BoolLiteral(b bool) string {
+	return strconv.FormatBool(b)
+}
+
+// Quote encloses the string with backquotes.
+func (ch ClickHouse) Quote(s string) string {
+	return query.Quote(s)
+}
+
+// AutoIncrement return empty string because ClickHouse has no auto-increment.
+func (ch ClickHouse) AutoIncrement() string {
+	return ""
+}
+
+// FormatAttribute return column attributes for ClickHouse.
+// Columns are NOT NULL by default, and NULL modifier can not be used with Nullable(T).
+// It returns ErrAutoIncrementNotSupported if "auto" tag is specified.
+func (ch ClickHouse) FormatAttribute(sqlType string, null bool, defaultValue *string, auto bool) (string, error) {
+	if auto {
+		return "", ErrAutoIncrementNotSupported
+	}
+	var attributes []string
+
+	if null && !strings.HasPrefix(sqlType, "Nullable(") {
+		attributes = append(attributes, "NULL")
+	}
+	if defaultValue != nil {
+		attributes = append(attributes, "DEFAULT", *defaultValue)
+	}
+
+	return strings.Join(attributes, " "), nil
+}
+
+// PrimaryKeySQL return sorting key sql string of dialect-agnostic primary key.
+func (ch ClickHouse) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return data skipping index sql string of dialect-agnostic index.
+// ClickHouse has no unique index, so the unique index is the data skipping index
+// without uniqueness, and a warning is logged.
+func (ch ClickHouse) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		log.Printf("warning: ClickHouse does not support unique indexes. %s of %s is not unique\n", index.Name(), table)
+	}
+	return AddIndex(index.Name(), index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return empty string because ClickHouse does not support foreign keys.
+func (ch ClickHouse) ForeignKeySQL(fk schema.ForeignKey) string {
+	return ""
+}
+
+// PrimaryKey is the sorting key of MergeTree family table engine
+type PrimaryKey struct {
+	columns     []string
+	partitionBy string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+// The columns are used as ORDER BY of table engine.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// WithPartitionBy set partition key expression. e.g. toYYYYMM(created_at)
+func (pk PrimaryKey) WithPartitionBy(expr string) PrimaryKey {
+	pk.partitionBy = expr
+	return pk
+}
+
+// Columns returns the columns that will be the sorting keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// PartitionBy return partition key expression
+func (pk PrimaryKey) PartitionBy() string {
+	return pk.partitionBy
+}
+
+// ToSQL return sorting key and partition key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	var columnsStr []string
+	for _, c := range pk.columns {
+		columnsStr = append(columnsStr, query.Quote(c))
+	}
+
+	sql := fmt.Sprintf("ORDER BY (%s)", strings.Join(columnsStr, ", "))
+	if pk.partitionBy != "" {
+		sql += fmt.Sprintf(" PARTITION BY %s", pk.partitionBy)
+	}
+	return sql
+}
+
+// Index is data skipping index
+type Index struct {
+	columns     []string
+	name        string
+	indexType   string
+	granularity uint64
+}
+
+// AddIndex returns a new Index. The index type is minmax and granularity is 1 by default.
+func AddIndex(idxName string, columns ...string) Index {
+	return Index{
+		name:        idxName,
+		columns:     columns,
+		indexType:   defaultIndexType,
+		granularity: defaultGranularity,
+	}
+}
+
+// WithType set index type. e.g. set(100), bloom_filter
+func (i Index) WithType(indexType string) Index {
+	i.indexType = indexType
+	return i
+}
+
+// WithGranularity set index granularity
+func (i Index) WithGranularity(granularity uint64) Index {
+	i.granularity = granularity
+	return i
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return i.name
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return i.columns
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	var columnsStr []string
+	for _, c := range i.columns {
+		columnsStr = append(columnsStr, query.Quote(c))
+	}
+	return fmt.Sprintf("INDEX %s (%s) TYPE %s GRANULARITY %d",
+		query.Quote(i.name), strings.Join(columnsStr, ", "), i.indexType, i.granularity)
+}
+
+func datetime64(size uint64) string {
+	if size == 0 {
+		return fmt.Sprintf("DateTime64(%d)", defaultDateTimePrecision)
+	}
+
+	return fmt.Sprintf("DateTime64(%d)", size)
+}
+
+// enum return Enum8 or Enum16 type. The values are numbered from 1 in declaration order.
+func (ch ClickHouse) enum(values []string) string {
+	elems := make([]string, 0, len(values))
+	for i, v := range values {
+		elems = append(elems, fmt.Sprintf("%s = %d", ch.StringLiteral(v), i+1))
+	}
+
+	enumType := "Enum8"
+	if len(values) > math.MaxInt8 {
+		enumType = "Enum16"
+	}
+	return fmt.Sprintf("%s(%s)", enumType, strings.Join(elems, ", "))
+}
+
+func decimal(opt sqltype.Option) string {
+	if opt.Precision == 0 {
+		return "Decimal(38, 18)"
+	}
+
+	return fmt.Sprintf("Decimal(%d, %d)", opt.Precision, opt.Scale)
+}
+
+// FormatGenerated return the definition of the generated column. The stored column is
+// MATERIALIZED column, and the other is ALIAS column that is computed when it is read.
+// The nullability is decided by the SQL type (e.g. Nullable(String)).
+func (ch ClickHouse) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	if storage == sqltype.Stored {
+		return fmt.Sprintf("%s MATERIALIZED %s", sqlType, expression), nil
+	}
+	return fmt.Sprintf("%s ALIAS %s", sqlType, expression), nil
+}
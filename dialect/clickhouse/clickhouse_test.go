@@ -0,0 +1,390 @@
+package clickhouse
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+func TestClickHouse_TableEngine(t *testing.T) {
+	tests := []struct {
+		name       string
+		clickhouse ClickHouse
+		want       string
+	}{
+		{
+			name:       "[Normal] return default engine",
+			clickhouse: ClickHouse{},
+			want:       "MergeTree()",
+		},
+		{
+			name:       "[Normal] return specified engine",
+			clickhouse: ClickHouse{Engine: "ReplacingMergeTree(updated_at)"},
+			want:       "ReplacingMergeTree(updated_at)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.clickhouse.TableEngine(); got != tt.want {
+				t.Errorf("ClickHouse.TableEngine() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClickHouse_SkipForeignKeys(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	ch := ClickHouse{}
+	if got := ch.SkipForeignKeys("`player`", 0); got != "" {
+		t.Errorf("ClickHouse.SkipForeignKeys() = %v, want empty", got)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("warning is logged without foreign keys: %s", buf.String())
+	}
+
+	if got := ch.SkipForeignKeys("`player`", 2); got != "" {
+		t.Errorf("ClickHouse.SkipForeignKeys() = %v, want empty", got)
+	}
+	if !strings.Contains(buf.String(), "skip 2 foreign key(s) of `player`") {
+		t.Errorf("warning is not logged: %s", buf.String())
+	}
+}
+
+func TestClickHouse_ToSQL(t *testing.T) {
+	ch := ClickHouse{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "Bool"},
+		{"*bool", 0, "Nullable(Bool)"},
+		{"sql.NullBool", 0, "Nullable(Bool)"},
+		{"int8", 0, "Int8"},
+		{"int16", 0, "Int16"},
+		{"int32", 0, "Int32"},
+		{"sql.NullInt32", 0, "Nullable(Int32)"},
+		{"int64", 0, "Int64"},
+		{"*int64", 0, "Nullable(Int64)"},
+		{"uint8", 0, "UInt8"},
+		{"uint16", 0, "UInt16"},
+		{"uint32", 0, "UInt32"},
+		{"uint64", 0, "UInt64"},
+		{"float32", 0, "Float32"},
+		{"float64", 0, "Float64"},
+		{"decimal.Decimal", 0, "Decimal(38, 18)"},
+		{"big.Rat", 0, "Decimal(38, 18)"},
+		{"sql.NullFloat64", 0, "Nullable(Float64)"},
+		{"string", 0, "String"},
+		{"*string", 0, "Nullable(String)"},
+		{"sql.NullString", 0, "Nullable(String)"},
+		{"lowcardinality", 0, "LowCardinality(String)"},
+		{"[]uint8", 0, "String"},
+		{"text", 0, "String"},
+		{"time.Time", 0, "DateTime64(3)"},
+		{"time.Time", 6, "DateTime64(6)"},
+		{"*time.Time", 0, "Nullable(DateTime64(3))"},
+		{"sql.NullTime", 0, "Nullable(DateTime64(3))"},
+		{"date", 0, "Date"},
+		{"uuid", 0, "UUID"},
+	}
+
+	for _, tc := range testcases {
+		got, err := ch.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	for _, typeName := range []string{"noExistType", "*noExistType"} {
+		if _, err := ch.ToSQL(typeName, sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+			t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+		}
+	}
+}
+
+func TestClickHouse_FormatAttribute(t *testing.T) {
+	zero := "0"
+	tests := []struct {
+		name         string
+		sqlType      string
+		null         bool
+		defaultValue *string
+		want         string
+	}{
+		{
+			name:    "[Normal] not null is default",
+			sqlType: "String",
+			want:    "",
+		},
+		{
+			name:    "[Normal] null modifier",
+			sqlType: "String",
+			null:    true,
+			want:    "NULL",
+		},
+		{
+			name:    "[Normal] null modifier is omitted for Nullable type",
+			sqlType: "Nullable(String)",
+			null:    true,
+			want:    "",
+		},
+		{
+			name:         "[Normal] default",
+			sqlType:      "Int32",
+			defaultValue: &zero,
+			want:         "DEFAULT 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := ClickHouse{}
+			got, err := ch.FormatAttribute(tt.sqlType, tt.null, tt.defaultValue, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ClickHouse.FormatAttribute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("[Error] auto increment", func(t *testing.T) {
+		ch := ClickHouse{}
+		if _, err := ch.FormatAttribute("UInt64", false, nil, true); !errors.Is(err, ErrAutoIncrementNotSupported) {
+			t.Errorf("mismatch want=%v, got=%v", ErrAutoIncrementNotSupported, err)
+		}
+	})
+}
+
+func TestClickHouse_IndexSQL(t *testing.T) {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+	defer log.SetOutput(os.Stderr)
+
+	ch := ClickHouse{}
+	want := "INDEX `path_idx` (`path`) TYPE minmax GRANULARITY 1"
+	if got := ch.IndexSQL("`page_view`", schema.AddIndex("path_idx", "path")); got != want {
+		t.Errorf("ClickHouse.IndexSQL() = %v, want %v", got, want)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("warning is logged for the index: %s", buf.String())
+	}
+
+	if got := ch.IndexSQL("`page_view`", schema.AddUniqueIndex("path_idx", "path")); got != want {
+		t.Errorf("ClickHouse.IndexSQL() = %v, want %v", got, want)
+	}
+	if !strings.Contains(buf.String(), "path_idx of `page_view` is not unique") {
+		t.Errorf("warning is not logged for the unique index: %s", buf.String())
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("created_at", "id")
+	if pk.ToSQL() != "ORDER BY (`created_at`, `id`)" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+
+	pk = pk.WithPartitionBy("toYYYYMM(created_at)")
+	if pk.PartitionBy() != "toYYYYMM(created_at)" {
+		t.Errorf("PrimaryKey.PartitionBy() = %v", pk.PartitionBy())
+	}
+	if pk.ToSQL() != "ORDER BY (`created_at`, `id`) PARTITION BY toYYYYMM(created_at)" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("path_idx", "path")
+	if index.ToSQL() != "INDEX `path_idx` (`path`) TYPE minmax GRANULARITY 1" {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+
+	index = AddIndex("path_idx", "path", "referrer").WithType("bloom_filter").WithGranularity(4)
+	if index.ToSQL() != "INDEX `path_idx` (`path`, `referrer`) TYPE bloom_filter GRANULARITY 4" {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestClickHouse_ToSQL_Decimal(t *testing.T) {
+	ch := ClickHouse{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "Decimal(38, 18)",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "Decimal(12, 2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "Nullable(Decimal(12, 2))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ch.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ClickHouse.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClickHouse_ToSQL_Enum(t *testing.T) {
+	ch := ClickHouse{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] enum",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "Enum8('active' = 1, 'inactive' = 2)",
+		},
+		{
+			name:     "[Normal] nullable enum",
+			typeName: "*string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "Nullable(Enum8('active' = 1, 'inactive' = 2))",
+		},
+		{
+			name:     "[Normal] enum values with a quote and a backslash are escaped",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"it's", `a\b`}},
+			want:     `Enum8('it''s' = 1, 'a\\b' = 2)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ch.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestClickHouse_ToSQL_Enum16(t *testing.T) {
+	values := make([]string, 128)
+	for i := range values {
+		values[i] = fmt.Sprintf("v%d", i)
+	}
+
+	got, err := ClickHouse{}.ToSQL("string", sqltype.Option{Enum: values})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got, "Enum16('v0' = 1, ") || !strings.HasSuffix(got, "'v127' = 128)") {
+		t.Errorf("ToSQL() = %s, want Enum16 that has 128 values", got)
+	}
+}
+
+func TestClickHouse_CommentSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    string
+	}{
+		{name: "[Normal] comment", comment: "Journal ID", want: "COMMENT 'Journal ID'"},
+		{name: "[Normal] single quote is escaped", comment: "User's journals", want: "COMMENT 'User''s journals'"},
+		{name: "[Normal] backslash is escaped", comment: `C:\journals`, want: `COMMENT 'C:\\journals'`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := ClickHouse{}
+			if got := ch.CommentSQL(tt.comment); got != tt.want {
+				t.Errorf("ClickHouse.CommentSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClickHouse_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage sqltype.Storage
+		null    bool
+		want    string
+	}{
+		{
+			name: "[Normal] storage is not specified, so the column is ALIAS",
+			want: "Int64 ALIAS price * quantity",
+		},
+		{
+			name:    "[Normal] virtual column is ALIAS",
+			storage: sqltype.Virtual,
+			want:    "Int64 ALIAS price * quantity",
+		},
+		{
+			name:    "[Normal] stored column is MATERIALIZED",
+			storage: sqltype.Stored,
+			want:    "Int64 MATERIALIZED price * quantity",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ch := ClickHouse{}
+			got, err := ch.FormatGenerated("Int64", "price * quantity", tt.storage, tt.null)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("ClickHouse.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClickHouse_StringLiteral(t *testing.T) {
+	ch := ClickHouse{}
+	if got, want := ch.StringLiteral(`it's C:\dir`), `'it''s C:\\dir'`; got != want {
+		t.Errorf("ClickHouse.StringLiteral() = %v, want %v", got, want)
+	}
+}
+
+func TestClickHouse_BoolLiteral(t *testing.T) {
+	ch := ClickHouse{}
+	if got := ch.BoolLiteral(true); got != "true" {
+		t.Errorf("ClickHouse.BoolLiteral(true) = %v, want true", got)
+	}
+	if got := ch.BoolLiteral(false); got != "false" {
+		t.Errorf("ClickHouse.BoolLiteral(false) = %v, want false", got)
+	}
+}
@@ -0,0 +1,407 @@
+package cockroach
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrInvalidAutoType means "auto" tag is specified for the column that is neither INT8 nor UUID
+	ErrInvalidAutoType = errors.New("auto tag can be specified only for INT8 (int64) or UUID column")
+)
+
+const (
+	uniqueRowID   = "unique_rowid()"
+	randomUUID    = "gen_random_uuid()"
+	stringType    = "STRING"
+	defaultBucket = 0
+)
+
+// CockroachDB is a model for CockroachDB.
+// Foreign keys are the same as PostgreSQL, so use them in postgres package.
+type CockroachDB struct{}
+
+// HeaderTemplate return string that is sql header template.
+// CockroachDB does not recommend schema changes in explicit transaction, so it is empty.
+func (crdb CockroachDB) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (crdb CockroachDB) FooterTemplate() string {
+	return ""
+}
+
+// TableTemplate return string that is sql table template.
+// Indexes are created after the table because USING HASH and STORING clauses
+// are written in CREATE INDEX statement. The enum types are created before the table.
+func (crdb CockroachDB) TableTemplate() string {
+	return `
+DROP TABLE IF EXISTS {{ .Name }} CASCADE;
+{{ range .Columns }}{{ if .EnumValues -}}
+DROP TYPE IF EXISTS {{ $.Dialect.Quote .EnumName }};
+CREATE TYPE {{ $.Dialect.Quote .EnumName }} AS ENUM ({{ .EnumLiterals }});
+{{ end }}{{ end }}
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+}
+
+// ToSQL convert cockroachdb sql string from typeName and type options (e.g. size).
+// Go slices (except []byte) are converted to ARRAY type (e.g. []string to STRING[]).
+// The enum column is converted to the enum type created by TableTemplate.
+func (crdb CockroachDB) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if opt.IsEnum() {
+		return crdb.Quote(opt.EnumName), nil
+	}
+	if strings.HasPrefix(typeName, "*") {
+		return crdb.ToSQL(strings.TrimPrefix(typeName, "*"), opt)
+	}
+	if strings.HasPrefix(typeName, "[]") && typeName != "[]uint8" {
+		elem, err := crdb.ToSQL(strings.TrimPrefix(typeName, "[]"), opt)
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	}
+
+	switch typeName {
+	case "int8", "int16", "sql.NullInt16", "uint8", "sql.NullByte":
+		return "INT2", nil
+	case "int32", "sql.NullInt32", "uint16":
+		return "INT4", nil
+	case "int64", "sql.NullInt64", "uint32", "uint64":
+		return "INT8", nil
+	case "float32":
+		return "FLOAT4", nil
+	case "float64", "sql.NullFloat64":
+		return "FLOAT8", nil
+	case "decimal", "decimal.Decimal", "big.Rat":
+		return "DECIMAL" + opt.PrecisionScale(""), nil
+	case "string", "sql.NullString":
+		return str(opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return "BYTES", nil
+	case "bool", "sql.NullBool":
+		return "BOOL", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return stringType, nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "BYTES", nil
+	case "time":
+		return "TIME", nil
+	case "time.Time", "sql.NullTime":
+		return timestamptz(opt.Size), nil
+	case "date":
+		return "DATE", nil
+	case "json.RawMessage", "json":
+		return "JSONB", nil
+	case "uuid", "uuid.UUID":
+		return "UUID", nil
+	case "inet", "netip.Addr":
+		return "INET", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// Quote encloses the string with "".
+func (crdb CockroachDB) Quote(s string) string {
+	return query.DoubleQuote(s)
+}
+
+// AutoIncrement return empty string. CockroachDB uses unique_rowid() or
+// gen_random_uuid() instead of SERIAL, and they are written by FormatAttribute.
+func (crdb CockroachDB) AutoIncrement() string {
+	return ""
+}
+
+// FormatAttribute return column attributes for CockroachDB.
+// The column that has "auto" tag uses unique_rowid() (INT8) or gen_random_uuid() (UUID)
+// as the default value.
+func (crdb CockroachDB) FormatAttribute(sqlType string, null bool, defaultValue *string, auto bool) (string, error) {
+	var attributes []string
+
+	if null {
+		attributes = append(attributes, "NULL")
+	} else {
+		attributes = append(attributes, "NOT NULL")
+	}
+
+	if auto {
+		switch sqlType {
+		case "INT8":
+			attributes = append(attributes, "DEFAULT", uniqueRowID)
+		case "UUID":
+			attributes = append(attributes, "DEFAULT", randomUUID)
+		default:
+			return "", fmt.Errorf("%w: %s", ErrInvalidAutoType, sqlType)
+		}
+	} else if defaultValue != nil {
+		attributes = append(attributes, "DEFAULT", *defaultValue)
+	}
+
+	return strings.Join(attributes, " "), nil
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (crdb CockroachDB) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (crdb CockroachDB) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+// Foreign keys are the same as PostgreSQL.
+func (crdb CockroachDB) ForeignKeySQL(fk schema.ForeignKey) string {
+	return postgres.PostgreSQL{}.ForeignKeySQL(fk)
+}
+
+// PrimaryKey is a model for determining the primary key
+type PrimaryKey struct {
+	columns    []string
+	hashShards bool
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// WithHashSharded makes the primary key hash-sharded (USING HASH).
+// It is useful for sequential keys (e.g. timestamp) to avoid hot spots.
+func (pk PrimaryKey) WithHashSharded() PrimaryKey {
+	pk.hashShards = true
+	return pk
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	sql := fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+	if pk.hashShards {
+		sql += " USING HASH"
+	}
+	return sql
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+	options indexOptions
+}
+
+// indexOptions is CockroachDB specific index options
+type indexOptions struct {
+	hashShards  bool
+	bucketCount uint64
+	storing     []string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// WithHashSharded makes the index hash-sharded (USING HASH).
+// If bucketCount is 0, the default bucket count of the cluster is used.
+func (i Index) WithHashSharded(bucketCount uint64) Index {
+	i.options.hashShards = true
+	i.options.bucketCount = bucketCount
+	return i
+}
+
+// WithStoring set the columns that are stored in the index (STORING clause).
+func (i Index) WithStoring(columns ...string) Index {
+	i.options.storing = columns
+	return i
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return query.DoubleQuote(i.name)
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return query.DoubleQuote(i.table)
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return quoteAll(i.columns)
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// Storing return stored columns
+func (i Index) Storing() []string {
+	return quoteAll(i.options.storing)
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s)%s;",
+		i.Name(), i.Table(), strings.Join(i.Columns(), ", "), i.options.toSQL())
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+	options indexOptions
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// WithHashSharded makes the unique index hash-sharded (USING HASH).
+// If bucketCount is 0, the default bucket count of the cluster is used.
+func (ui UniqueIndex) WithHashSharded(bucketCount uint64) UniqueIndex {
+	ui.options.hashShards = true
+	ui.options.bucketCount = bucketCount
+	return ui
+}
+
+// WithStoring set the columns that are stored in the unique index (STORING clause).
+func (ui UniqueIndex) WithStoring(columns ...string) UniqueIndex {
+	ui.options.storing = columns
+	return ui
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return query.DoubleQuote(ui.name)
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return query.DoubleQuote(ui.table)
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return quoteAll(ui.columns)
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// Storing return stored columns
+func (ui UniqueIndex) Storing() []string {
+	return quoteAll(ui.options.storing)
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s)%s;",
+		ui.Name(), ui.Table(), strings.Join(ui.Columns(), ", "), ui.options.toSQL())
+}
+
+// toSQL return USING HASH, STORING and WITH clauses.
+func (o indexOptions) toSQL() string {
+	var sql string
+	if o.hashShards {
+		sql += " USING HASH"
+	}
+	if len(o.storing) != 0 {
+		sql += fmt.Sprintf(" STORING (%s)", strings.Join(quoteAll(o.storing), ", "))
+	}
+	if o.hashShards && o.bucketCount != defaultBucket {
+		sql += fmt.Sprintf(" WITH (bucket_count = %d)", o.bucketCount)
+	}
+	return sql
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.DoubleQuote(s))
+	}
+	return quoted
+}
+
+func str(size uint64) string {
+	if size == 0 {
+		return stringType
+	}
+
+	return fmt.Sprintf("%s(%d)", stringType, size)
+}
+
+func timestamptz(size uint64) string {
+	if size == 0 {
+		return "TIMESTAMPTZ"
+	}
+
+	return fmt.Sprintf("TIMESTAMPTZ(%d)", size)
+}
+
+// FormatGenerated return the definition of the generated column. The column is
+// STORED if storage is not specified.
+func (crdb CockroachDB) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	if storage == sqltype.StorageDefault {
+		storage = sqltype.Stored
+	}
+	definition := fmt.Sprintf("%s GENERATED ALWAYS AS (%s) %s", sqlType, expression, storage)
+	if null {
+		return definition + " NULL", nil
+	}
+	return definition + " NOT NULL", nil
+}
@@ -0,0 +1,296 @@
+package cockroach
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestCockroachDB_ToSQL(t *testing.T) {
+	crdb := CockroachDB{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "BOOL"},
+		{"*bool", 0, "BOOL"},
+		{"sql.NullBool", 0, "BOOL"},
+		{"int8", 0, "INT2"},
+		{"int16", 0, "INT2"},
+		{"int32", 0, "INT4"},
+		{"int64", 0, "INT8"},
+		{"*int64", 0, "INT8"},
+		{"sql.NullInt64", 0, "INT8"},
+		{"uint64", 0, "INT8"},
+		{"float32", 0, "FLOAT4"},
+		{"float64", 0, "FLOAT8"},
+		{"decimal.Decimal", 0, "DECIMAL"},
+		{"big.Rat", 0, "DECIMAL"},
+		{"string", 0, "STRING"},
+		{"string", 64, "STRING(64)"},
+		{"sql.NullString", 0, "STRING"},
+		{"text", 0, "STRING"},
+		{"[]uint8", 0, "BYTES"},
+		{"blob", 0, "BYTES"},
+		{"time.Time", 0, "TIMESTAMPTZ"},
+		{"time.Time", 6, "TIMESTAMPTZ(6)"},
+		{"sql.NullTime", 0, "TIMESTAMPTZ"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "JSONB"},
+		{"uuid", 0, "UUID"},
+		{"inet", 0, "INET"},
+		{"[]string", 0, "STRING[]"},
+		{"[]int64", 0, "INT8[]"},
+	}
+
+	for _, tc := range testcases {
+		got, err := crdb.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	if _, err := crdb.ToSQL("noExistType", sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+	}
+}
+
+func TestCockroachDB_FormatAttribute(t *testing.T) {
+	zero := "0"
+	tests := []struct {
+		name         string
+		sqlType      string
+		null         bool
+		defaultValue *string
+		auto         bool
+		want         string
+		wantErr      error
+	}{
+		{
+			name:    "[Normal] not null",
+			sqlType: "INT8",
+			want:    "NOT NULL",
+		},
+		{
+			name:    "[Normal] null",
+			sqlType: "INT8",
+			null:    true,
+			want:    "NULL",
+		},
+		{
+			name:         "[Normal] default",
+			sqlType:      "INT8",
+			defaultValue: &zero,
+			want:         "NOT NULL DEFAULT 0",
+		},
+		{
+			name:    "[Normal] auto INT8 uses unique_rowid()",
+			sqlType: "INT8",
+			auto:    true,
+			want:    "NOT NULL DEFAULT unique_rowid()",
+		},
+		{
+			name:    "[Normal] auto UUID uses gen_random_uuid()",
+			sqlType: "UUID",
+			auto:    true,
+			want:    "NOT NULL DEFAULT gen_random_uuid()",
+		},
+		{
+			name:    "[Error] auto INT4 is not supported",
+			sqlType: "INT4",
+			auto:    true,
+			wantErr: ErrInvalidAutoType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crdb := CockroachDB{}
+			got, err := crdb.FormatAttribute(tt.sqlType, tt.null, tt.defaultValue, tt.auto)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("CockroachDB.FormatAttribute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id")
+	if pk.ToSQL() != `PRIMARY KEY ("id")` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+
+	pk = AddPrimaryKey("created_at", "id").WithHashSharded()
+	if pk.ToSQL() != `PRIMARY KEY ("created_at", "id") USING HASH` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		index Index
+		want  string
+	}{
+		{
+			name:  "[Normal] index",
+			index: AddIndex("event_created_at_idx", "event", "created_at"),
+			want:  `CREATE INDEX "event_created_at_idx" ON "event" ("created_at");`,
+		},
+		{
+			name:  "[Normal] hash-sharded index with default bucket count",
+			index: AddIndex("event_created_at_idx", "event", "created_at").WithHashSharded(0),
+			want:  `CREATE INDEX "event_created_at_idx" ON "event" ("created_at") USING HASH;`,
+		},
+		{
+			name: "[Normal] hash-sharded index storing columns",
+			index: AddIndex("event_created_at_idx", "event", "created_at").
+				WithHashSharded(8).WithStoring("kind", "payload"),
+			want: `CREATE INDEX "event_created_at_idx" ON "event" ("created_at") USING HASH STORING ("kind", "payload") WITH (bucket_count = 8);`,
+		},
+		{
+			name:  "[Normal] storing columns",
+			index: AddIndex("event_kind_idx", "event", "kind").WithStoring("payload"),
+			want:  `CREATE INDEX "event_kind_idx" ON "event" ("kind") STORING ("payload");`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.index.ToSQL(); got != tt.want {
+				t.Errorf("Index.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddUniqueIndex(t *testing.T) {
+	index := AddUniqueIndex("event_key_uniq_idx", "event", "key")
+	if index.ToSQL() != `CREATE UNIQUE INDEX "event_key_uniq_idx" ON "event" ("key");` {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+
+	index = index.WithHashSharded(16).WithStoring("kind")
+	if index.ToSQL() != `CREATE UNIQUE INDEX "event_key_uniq_idx" ON "event" ("key") USING HASH STORING ("kind") WITH (bucket_count = 16);` {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestCockroachDB_ToSQL_Decimal(t *testing.T) {
+	crdb := CockroachDB{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "DECIMAL",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := crdb.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("CockroachDB.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCockroachDB_ToSQL_Enum(t *testing.T) {
+	crdb := CockroachDB{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] enum",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     `"users_status"`,
+		},
+		{
+			name:     "[Normal] nullable enum",
+			typeName: "*string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     `"users_status"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := crdb.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestCockroachDB_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage sqltype.Storage
+		null    bool
+		want    string
+	}{
+		{
+			name: "[Normal] storage is not specified, so the column is stored",
+			want: "INT8 GENERATED ALWAYS AS (price * quantity) STORED NOT NULL",
+		},
+		{
+			name:    "[Normal] virtual column",
+			storage: sqltype.Virtual,
+			want:    "INT8 GENERATED ALWAYS AS (price * quantity) VIRTUAL NOT NULL",
+		},
+		{
+			name:    "[Normal] nullable stored column",
+			storage: sqltype.Stored,
+			null:    true,
+			want:    "INT8 GENERATED ALWAYS AS (price * quantity) STORED NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crdb := CockroachDB{}
+			got, err := crdb.FormatGenerated("INT8", "price * quantity", tt.storage, tt.null)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("CockroachDB.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,9 +3,9 @@ package dialect
 import (
 	"fmt"
 	"sort"
+	"sync"
 
-	"github.com/nao1215/ddl-maker/dialect/mysql"
-	"github.com/nao1215/ddl-maker/dialect/sqlite"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 )
 
 // Dialect is interface that eliminates differences in DB drivers.
@@ -13,7 +13,7 @@ type Dialect interface {
 	HeaderTemplate() string
 	FooterTemplate() string
 	TableTemplate() string
-	ToSQL(typeName string, size uint64) (string, error)
+	ToSQL(typeName string, opt sqltype.Option) (string, error)
 	Quote(string) string
 	AutoIncrement() string
 }
@@ -101,21 +101,79 @@ func (indexes Indexes) Sort() Indexes {
 	return sortIndexes
 }
 
-// New creates a Dialect and returns it.
-func New(driver, engine, charset string) (Dialect, error) {
-	var d Dialect
-
-	switch driver {
-	case "mysql":
-		d = &mysql.MySQL{
-			Engine:  engine,
-			Charset: charset,
-		}
-	case "sqlite":
-		d = &sqlite.SQLite{}
-	default:
-		return d, fmt.Errorf("No such driver: %s", driver)
+// Check is the named CHECK constraint of the table.
+type Check interface {
+	Name() string
+	Expression() string
+	Columns() []string
+	ToSQL() string
+}
+
+// DBConfig is database settings that is passed to the factory of Dialect.
+type DBConfig struct {
+	// Driver is the name of registered dialect (e.g. mysql, postgres)
+	Driver string
+	// Engine is table engine (e.g. InnoDB for MySQL, MergeTree() for ClickHouse)
+	Engine string
+	// Charset is default character set of table
+	Charset string
+	// Version is the database server version (e.g. "10.11" for MariaDB).
+	// If it is empty, the latest version is assumed except for PostgreSQL,
+	// whose features of the versions before 18 are used.
+	Version string
+}
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]func(DBConfig) (Dialect, error))
+)
+
+// Register makes a dialect available by the provided driver name.
+// If Register is called twice with the same name or if factory is nil, it panics.
+func Register(name string, factory func(DBConfig) (Dialect, error)) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+
+	if factory == nil {
+		panic("dialect: Register factory is nil")
 	}
+	if _, dup := drivers[name]; dup {
+		panic("dialect: Register called twice for driver " + name)
+	}
+	drivers[name] = factory
+}
+
+// Drivers returns a sorted list of the names of the registered dialects.
+func Drivers() []string {
+	driversMu.RLock()
+	defer driversMu.RUnlock()
+
+	list := make([]string, 0, len(drivers))
+	for name := range drivers {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
 
-	return d, nil
+// New creates a Dialect and returns it. Use NewWithConfig to set the other settings
+// (e.g. server version).
+func New(driver, engine, charset string) (Dialect, error) {
+	return NewWithConfig(DBConfig{
+		Driver:  driver,
+		Engine:  engine,
+		Charset: charset,
+	})
+}
+
+// NewWithConfig creates a Dialect of conf.Driver and returns it.
+func NewWithConfig(conf DBConfig) (Dialect, error) {
+	driversMu.RLock()
+	factory, ok := drivers[conf.Driver]
+	driversMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("No such driver: %s", conf.Driver)
+	}
+	return factory(conf)
 }
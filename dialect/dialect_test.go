@@ -1,10 +1,19 @@
 package dialect
 
 import (
+	"net/netip"
 	"reflect"
 	"testing"
 
+	"github.com/nao1215/ddl-maker/dialect/clickhouse"
+	"github.com/nao1215/ddl-maker/dialect/cockroach"
+	"github.com/nao1215/ddl-maker/dialect/duckdb"
+	"github.com/nao1215/ddl-maker/dialect/mariadb"
+	"github.com/nao1215/ddl-maker/dialect/mssql"
 	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/oracle"
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/spanner"
 	"github.com/nao1215/ddl-maker/dialect/sqlite"
 )
 
@@ -145,3 +154,346 @@ func TestNew(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWithConfig(t *testing.T) {
+	type args struct {
+		driver  string
+		engine  string
+		charset string
+		version string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    Dialect
+		wantErr bool
+	}{
+		{
+			name: "[Normal] return mysql dialect",
+			args: args{
+				driver:  "mysql",
+				engine:  "",
+				charset: "",
+			},
+			want:    &mysql.MySQL{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return sqlite dialect",
+			args: args{
+				driver:  "sqlite",
+				engine:  "",
+				charset: "",
+			},
+			want:    &sqlite.SQLite{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return postgres dialect",
+			args: args{
+				driver:  "postgres",
+				engine:  "",
+				charset: "",
+			},
+			want:    &postgres.PostgreSQL{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return postgres dialect of the server version",
+			args: args{
+				driver:  "postgres",
+				engine:  "",
+				charset: "",
+				version: "18.1",
+			},
+			want:    &postgres.PostgreSQL{MajorVersion: 18},
+			wantErr: false,
+		},
+		{
+			name: "[Error] invalid postgres version",
+			args: args{
+				driver:  "postgres",
+				engine:  "",
+				charset: "",
+				version: "latest",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "[Normal] return sql server dialect",
+			args: args{
+				driver:  "mssql",
+				engine:  "",
+				charset: "",
+			},
+			want:    &mssql.SQLServer{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return sql server dialect with sqlserver alias",
+			args: args{
+				driver:  "sqlserver",
+				engine:  "",
+				charset: "",
+			},
+			want:    &mssql.SQLServer{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return oracle dialect",
+			args: args{
+				driver:  "oracle",
+				engine:  "",
+				charset: "",
+			},
+			want:    &oracle.Oracle{MaxIdentifierLength: 128},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return oracle dialect before 12.2",
+			args: args{
+				driver:  "oracle",
+				engine:  "",
+				charset: "",
+				version: "12.1.0.2",
+			},
+			want:    &oracle.Oracle{MaxIdentifierLength: 30},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return oracle dialect from 12.2",
+			args: args{
+				driver:  "oracle",
+				engine:  "",
+				charset: "",
+				version: "19c",
+			},
+			want:    &oracle.Oracle{MaxIdentifierLength: 128},
+			wantErr: false,
+		},
+		{
+			name: "[Error] invalid oracle version",
+			args: args{
+				driver:  "oracle",
+				engine:  "",
+				charset: "",
+				version: "twelve",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "[Normal] return clickhouse dialect",
+			args: args{
+				driver:  "clickhouse",
+				engine:  "ReplacingMergeTree()",
+				charset: "",
+			},
+			want:    &clickhouse.ClickHouse{Engine: "ReplacingMergeTree()"},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return duckdb dialect",
+			args: args{
+				driver:  "duckdb",
+				engine:  "",
+				charset: "",
+			},
+			want:    &duckdb.DuckDB{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return cockroach dialect",
+			args: args{
+				driver:  "cockroach",
+				engine:  "",
+				charset: "",
+			},
+			want:    &cockroach.CockroachDB{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return spanner dialect",
+			args: args{
+				driver:  "spanner",
+				engine:  "",
+				charset: "",
+			},
+			want:    &spanner.Spanner{},
+			wantErr: false,
+		},
+		{
+			name: "[Normal] return mariadb dialect",
+			args: args{
+				driver:  "mariadb",
+				engine:  "InnoDB",
+				charset: "utf8mb4",
+				version: "10.6.12-MariaDB",
+			},
+			want: &mariadb.MariaDB{
+				MySQL:   mysql.MySQL{Engine: "InnoDB", Charset: "utf8mb4"},
+				Version: mariadb.Version{Major: 10, Minor: 6},
+			},
+			wantErr: false,
+		},
+		{
+			name: "[Error] invalid mariadb version",
+			args: args{
+				driver:  "mariadb",
+				engine:  "",
+				charset: "",
+				version: "ten",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "[Error] no such driver",
+			args: args{
+				driver:  "unknown",
+				engine:  "",
+				charset: "",
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewWithConfig(DBConfig{
+				Driver:  tt.args.driver,
+				Engine:  tt.args.engine,
+				Charset: tt.args.charset,
+				Version: tt.args.version,
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewWithConfig() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("NewWithConfig() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type customDialect struct {
+	mysql.MySQL
+	name string
+}
+
+func TestRegister(t *testing.T) {
+	t.Run("[Normal] register custom dialect", func(t *testing.T) {
+		Register("custom", func(conf DBConfig) (Dialect, error) {
+			return &customDialect{name: conf.Driver}, nil
+		})
+		defer func() {
+			driversMu.Lock()
+			delete(drivers, "custom")
+			driversMu.Unlock()
+		}()
+
+		got, err := NewWithConfig(DBConfig{Driver: "custom"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, &customDialect{name: "custom"}) {
+			t.Errorf("NewWithConfig() = %v, want %v", got, &customDialect{name: "custom"})
+		}
+	})
+
+	t.Run("[Error] register same name twice", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Register() does not panic")
+			}
+		}()
+		Register("mysql", func(conf DBConfig) (Dialect, error) {
+			return &mysql.MySQL{}, nil
+		})
+	})
+
+	t.Run("[Error] register nil factory", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Register() does not panic")
+			}
+		}()
+		Register("nil", nil)
+	})
+}
+
+func TestDrivers(t *testing.T) {
+	want := []string{
+		"clickhouse", "cockroach", "duckdb", "mariadb", "mssql", "mysql",
+		"oracle", "postgres", "spanner", "sqlite", "sqlserver",
+	}
+	if got := Drivers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Drivers() = %v, want %v", got, want)
+	}
+}
+
+type money int64
+
+func TestRegisterType(t *testing.T) {
+	RegisterType("mock", reflect.TypeOf(money(0)), "DECIMAL(19,4)")
+
+	tests := []struct {
+		name   string
+		driver string
+		rt     reflect.Type
+		want   string
+		wantOk bool
+	}{
+		{
+			name:   "[Normal] registered type",
+			driver: "mock",
+			rt:     reflect.TypeOf(money(0)),
+			want:   "DECIMAL(19,4)",
+			wantOk: true,
+		},
+		{
+			name:   "[Normal] built-in type",
+			driver: "postgres",
+			rt:     reflect.TypeOf(netip.Addr{}),
+			want:   "INET",
+			wantOk: true,
+		},
+		{
+			name:   "[Error] type is not registered for the driver",
+			driver: "postgres",
+			rt:     reflect.TypeOf(money(0)),
+		},
+		{
+			name:   "[Error] driver is not registered",
+			driver: "noExistDriver",
+			rt:     reflect.TypeOf(money(0)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LookupType(tt.driver, tt.rt)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("LookupType() = (%v, %v), want (%v, %v)", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestTypeName(t *testing.T) {
+	tests := []struct {
+		rt   reflect.Type
+		want string
+	}{
+		{reflect.TypeOf(netip.Addr{}), "net/netip.Addr"},
+		{reflect.TypeOf(money(0)), "github.com/nao1215/ddl-maker/dialect.money"},
+		{reflect.TypeOf(""), "string"},
+		{reflect.TypeOf([]string{}), "[]string"},
+	}
+	for _, tt := range tests {
+		if got := TypeName(tt.rt); got != tt.want {
+			t.Errorf("TypeName() = %v, want %v", got, tt.want)
+		}
+	}
+}
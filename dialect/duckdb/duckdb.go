@@ -0,0 +1,348 @@
+package duckdb
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrStoredGeneratedColumn means the stored generated column is specified
+	ErrStoredGeneratedColumn = errors.New("stored generated column is not supported")
+)
+
+// DuckDB is a model for DuckDB
+type DuckDB struct{}
+
+// HeaderTemplate return string that is sql header template
+func (duck DuckDB) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (duck DuckDB) FooterTemplate() string {
+	return ""
+}
+
+// TableTemplate return string that is sql table template.
+// DuckDB has no auto-increment, so a sequence is created for each column that has
+// "auto" tag, and it is used as the default value of the column.
+func (duck DuckDB) TableTemplate() string {
+	return `
+DROP TABLE IF EXISTS {{ .Name }};
+{{ range .Columns }}{{ if .Auto -}}
+DROP SEQUENCE IF EXISTS {{ $.Dialect.Quote ($.Dialect.SequenceName $.Name .Name) }};
+CREATE SEQUENCE {{ $.Dialect.Quote ($.Dialect.SequenceName $.Name .Name) }} START 1;
+{{ end }}{{ end }}
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }}{{ if .Auto }} DEFAULT nextval('{{ $.Dialect.SequenceName $.Name .Name }}'){{ end }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+}
+
+// SequenceName return sequence name for auto-increment column.
+// table may be quoted by Quote().
+func (duck DuckDB) SequenceName(table, column string) string {
+	return fmt.Sprintf("%s_%s_seq", strings.Trim(table, `"`), column)
+}
+
+// ToSQL convert duckdb sql string from typeName and type options (e.g. size).
+// Go slices (except []byte) are converted to LIST type (e.g. []string to VARCHAR[]).
+// The enum column is converted to ENUM type.
+func (duck DuckDB) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if opt.IsEnum() {
+		return fmt.Sprintf("ENUM(%s)", query.SingleQuoteList(opt.Enum)), nil
+	}
+	if strings.HasPrefix(typeName, "*") {
+		return duck.ToSQL(strings.TrimPrefix(typeName, "*"), opt)
+	}
+	if strings.HasPrefix(typeName, "[]") && typeName != "[]uint8" {
+		elem, err := duck.ToSQL(strings.TrimPrefix(typeName, "[]"), sqltype.Option{})
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	}
+
+	switch typeName {
+	case "int8":
+		return "TINYINT", nil
+	case "int16", "sql.NullInt16":
+		return "SMALLINT", nil
+	case "int32", "sql.NullInt32":
+		return "INTEGER", nil
+	case "int64", "sql.NullInt64":
+		return "BIGINT", nil
+	case "uint8", "sql.NullByte":
+		return "UTINYINT", nil
+	case "uint16":
+		return "USMALLINT", nil
+	case "uint32":
+		return "UINTEGER", nil
+	case "uint64":
+		return "UBIGINT", nil
+	case "big.Int", "hugeint":
+		return "HUGEINT", nil
+	case "float32":
+		return "FLOAT", nil
+	case "float64", "sql.NullFloat64":
+		return "DOUBLE", nil
+	case "decimal", "decimal.Decimal", "big.Rat":
+		return "DECIMAL" + opt.PrecisionScale("(38,18)"), nil
+	case "string", "sql.NullString":
+		return varchar(opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return "BLOB", nil
+	case "bool", "sql.NullBool":
+		return "BOOLEAN", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "VARCHAR", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "BLOB", nil
+	case "time":
+		return "TIME", nil
+	case "time.Time", "sql.NullTime":
+		return "TIMESTAMP", nil
+	case "date":
+		return "DATE", nil
+	case "json.RawMessage":
+		return "JSON", nil
+	case "uuid":
+		return "UUID", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// Quote encloses the string with "".
+func (duck DuckDB) Quote(s string) string {
+	return query.DoubleQuote(s)
+}
+
+// AutoIncrement return empty string because DuckDB uses sequence instead of auto-increment.
+func (duck DuckDB) AutoIncrement() string {
+	return ""
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (duck DuckDB) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (duck DuckDB) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+// DuckDB does not support referential actions, so they are omitted.
+func (duck DuckDB) ForeignKeySQL(fk schema.ForeignKey) string {
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName()).ToSQL()
+}
+
+// PrimaryKey is a model for determining the primary key
+type PrimaryKey struct {
+	columns []string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return query.DoubleQuote(i.name)
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return query.DoubleQuote(i.table)
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return quoteAll(i.columns)
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		i.Name(), i.Table(), strings.Join(i.Columns(), ", "))
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return query.DoubleQuote(ui.name)
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return query.DoubleQuote(ui.table)
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return quoteAll(ui.columns)
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
+		ui.Name(), ui.Table(), strings.Join(ui.Columns(), ", "))
+}
+
+// ForeignKey is a model for setting foreign key constraints.
+// DuckDB does not support ON UPDATE / ON DELETE actions.
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string) ForeignKey {
+	return ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return quoteAll(fk.foreignColumns)
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return query.DoubleQuote(fk.referenceTableName)
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return quoteAll(fk.referenceColumns)
+}
+
+// UpdateOption always return empty string because DuckDB does not support it.
+func (fk ForeignKey) UpdateOption() string {
+	return ""
+}
+
+// DeleteOption always return empty string because DuckDB does not support it.
+func (fk ForeignKey) DeleteOption() string {
+	return ""
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	return fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(fk.ForeignColumns(), ", "),
+		fk.ReferenceTableName(),
+		strings.Join(fk.ReferenceColumns(), ", "))
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.DoubleQuote(s))
+	}
+	return quoted
+}
+
+func varchar(size uint64) string {
+	if size == 0 {
+		return "VARCHAR"
+	}
+
+	return fmt.Sprintf("VARCHAR(%d)", size)
+}
+
+// FormatGenerated return the definition of the generated column. DuckDB supports
+// only the virtual generated column, and it does not allow NULL / NOT NULL constraint on it.
+func (duck DuckDB) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	if storage == sqltype.Stored {
+		return "", fmt.Errorf("%w: %s", ErrStoredGeneratedColumn, expression)
+	}
+	return fmt.Sprintf("%s GENERATED ALWAYS AS (%s) VIRTUAL", sqlType, expression), nil
+}
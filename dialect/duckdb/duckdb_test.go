@@ -0,0 +1,238 @@
+package duckdb
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestDuckDB_SequenceName(t *testing.T) {
+	duck := DuckDB{}
+	tests := []struct {
+		name   string
+		table  string
+		column string
+		want   string
+	}{
+		{
+			name:   "[Normal] table name is not quoted",
+			table:  "sale",
+			column: "id",
+			want:   "sale_id_seq",
+		},
+		{
+			name:   "[Normal] table name is quoted",
+			table:  `"sale"`,
+			column: "id",
+			want:   "sale_id_seq",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := duck.SequenceName(tt.table, tt.column); got != tt.want {
+				t.Errorf("DuckDB.SequenceName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuckDB_ToSQL(t *testing.T) {
+	duck := DuckDB{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "BOOLEAN"},
+		{"*bool", 0, "BOOLEAN"},
+		{"sql.NullBool", 0, "BOOLEAN"},
+		{"int8", 0, "TINYINT"},
+		{"int16", 0, "SMALLINT"},
+		{"int32", 0, "INTEGER"},
+		{"int64", 0, "BIGINT"},
+		{"sql.NullInt64", 0, "BIGINT"},
+		{"uint8", 0, "UTINYINT"},
+		{"uint16", 0, "USMALLINT"},
+		{"uint32", 0, "UINTEGER"},
+		{"uint64", 0, "UBIGINT"},
+		{"*big.Int", 0, "HUGEINT"},
+		{"hugeint", 0, "HUGEINT"},
+		{"float32", 0, "FLOAT"},
+		{"float64", 0, "DOUBLE"},
+		{"decimal.Decimal", 0, "DECIMAL(38,18)"},
+		{"big.Rat", 0, "DECIMAL(38,18)"},
+		{"string", 0, "VARCHAR"},
+		{"string", 10, "VARCHAR(10)"},
+		{"*string", 0, "VARCHAR"},
+		{"[]uint8", 0, "BLOB"},
+		{"[]string", 0, "VARCHAR[]"},
+		{"[]int64", 0, "BIGINT[]"},
+		{"[]time.Time", 0, "TIMESTAMP[]"},
+		{"text", 0, "VARCHAR"},
+		{"blob", 0, "BLOB"},
+		{"time", 0, "TIME"},
+		{"time.Time", 0, "TIMESTAMP"},
+		{"sql.NullTime", 0, "TIMESTAMP"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "JSON"},
+		{"uuid", 0, "UUID"},
+	}
+
+	for _, tc := range testcases {
+		got, err := duck.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	for _, typeName := range []string{"noExistType", "[]noExistType"} {
+		if _, err := duck.ToSQL(typeName, sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+			t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+		}
+	}
+}
+
+func TestDuckDB_Quote(t *testing.T) {
+	duck := DuckDB{}
+	if got := duck.Quote("test"); got != `"test"` {
+		t.Errorf("DuckDB.Quote() = %v, want %v", got, `"test"`)
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id", "created_at")
+	if pk.ToSQL() != `PRIMARY KEY ("id", "created_at")` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("store_id_idx", "sale", "store_id")
+	if index.ToSQL() != `CREATE INDEX "store_id_idx" ON "sale" ("store_id");` {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddUniqueIndex(t *testing.T) {
+	index := AddUniqueIndex("store_id_uniq_idx", "sale", "store_id", "sold_at")
+	if index.ToSQL() != `CREATE UNIQUE INDEX "store_id_uniq_idx" ON "sale" ("store_id", "sold_at");` {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	fk := AddForeignKey([]string{"store_id"}, []string{"id"}, "store")
+	if fk.ToSQL() != `FOREIGN KEY ("store_id") REFERENCES "store" ("id")` {
+		t.Errorf("ForeignKey.ToSQL() = %v", fk.ToSQL())
+	}
+	if fk.UpdateOption() != "" || fk.DeleteOption() != "" {
+		t.Errorf("DuckDB does not support foreign key options")
+	}
+}
+
+func TestDuckDB_ToSQL_Decimal(t *testing.T) {
+	duck := DuckDB{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "DECIMAL(38,18)",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := duck.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("DuckDB.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDuckDB_ToSQL_Enum(t *testing.T) {
+	duck := DuckDB{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] enum",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "ENUM('active', 'inactive')",
+		},
+		{
+			name:     "[Normal] nullable enum",
+			typeName: "*string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "ENUM('active', 'inactive')",
+		},
+		{
+			name:     "[Normal] enum value has single quote",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"it's"}},
+			want:     "ENUM('it''s')",
+		},
+		{
+			name:     "[Normal] enum value has backslash",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{`C:\dir`}},
+			want:     `ENUM('C:\dir')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := duck.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDuckDB_FormatGenerated(t *testing.T) {
+	duck := DuckDB{}
+	got, err := duck.FormatGenerated("INTEGER", "price * quantity", sqltype.Virtual, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "INTEGER GENERATED ALWAYS AS (price * quantity) VIRTUAL"; got != want {
+		t.Errorf("DuckDB.FormatGenerated() = %v, want %v", got, want)
+	}
+
+	if _, err := duck.FormatGenerated("INTEGER", "price * quantity", sqltype.Stored, false); !errors.Is(err, ErrStoredGeneratedColumn) {
+		t.Errorf("mismatch want=%v, got=%v", ErrStoredGeneratedColumn, err)
+	}
+}
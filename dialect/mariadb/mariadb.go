@@ -0,0 +1,180 @@
+package mariadb
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+var (
+	// ErrInvalidVersion means server version can not be parsed
+	ErrInvalidVersion = errors.New("MariaDB server version is invalid")
+	// ErrNotSupportedVersion means the feature is not supported by the server version
+	ErrNotSupportedVersion = errors.New("not supported by MariaDB server version")
+)
+
+// Version is MariaDB server version. Zero value means the latest version.
+type Version struct {
+	Major int
+	Minor int
+}
+
+// ParseVersion parse server version string (e.g. "10.6", "10.11.4-MariaDB").
+// If s is empty, it returns zero value that means the latest version.
+func ParseVersion(s string) (Version, error) {
+	if s == "" {
+		return Version{}, nil
+	}
+
+	elems := strings.SplitN(strings.SplitN(s, "-", 2)[0], ".", 3)
+	if len(elems) < 2 {
+		return Version{}, fmt.Errorf("%w: %s", ErrInvalidVersion, s)
+	}
+	major, err := strconv.Atoi(elems[0])
+	if err != nil {
+		return Version{}, fmt.Errorf("%w: %s", ErrInvalidVersion, s)
+	}
+	minor, err := strconv.Atoi(elems[1])
+	if err != nil {
+		return Version{}, fmt.Errorf("%w: %s", ErrInvalidVersion, s)
+	}
+	return Version{Major: major, Minor: minor}, nil
+}
+
+// AtLeast return whether the version is major.minor or later.
+func (v Version) AtLeast(major, minor int) bool {
+	if v == (Version{}) {
+		return true
+	}
+	if v.Major != major {
+		return v.Major > major
+	}
+	return v.Minor >= minor
+}
+
+// String return version string
+func (v Version) String() string {
+	if v == (Version{}) {
+		return "latest"
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// MariaDB is a model with database engine, character code and server version for MariaDB.
+// Indexes, primary key and foreign keys are the same as MySQL, so use them in mysql package.
+type MariaDB struct {
+	mysql.MySQL
+	Version Version
+}
+
+// TableTemplate return string that is sql table template.
+// JSON column is LONGTEXT with JSON_VALID() check. Sequence is created for
+// each column that has "sequence" tag, and "invisible" tag hides the column.
+func (m MariaDB) TableTemplate() string {
+	return `
+DROP TABLE IF EXISTS {{ .Name }};
+{{ range .Columns }}{{ if .HasSpec "sequence" -}}
+DROP SEQUENCE IF EXISTS {{ $.Dialect.Quote ($.Dialect.SequenceName $.Name .Name) }};
+CREATE SEQUENCE {{ $.Dialect.Quote ($.Dialect.SequenceName $.Name .Name) }} START WITH 1 INCREMENT BY 1;
+{{ end }}{{ end }}
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }}
+        {{- if .HasSpec "sequence" }} DEFAULT NEXTVAL({{ $.Dialect.Quote ($.Dialect.SequenceName $.Name .Name) }}){{ end }}
+        {{- if .HasSpec "invisible" }} {{ $.Dialect.Invisible }}{{ end }},
+    {{ end -}}
+    {{ range .Columns }}{{ if $.Dialect.IsJSON .Type -}}
+        CHECK (JSON_VALID({{ $.Dialect.Quote .Name }})),
+    {{ end }}{{ end -}}
+    {{ range .Indexes.Sort -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+) ENGINE={{ .Dialect.Engine }} DEFAULT CHARACTER SET {{ .Dialect.Charset }}{{ if .Comment }} {{ .Dialect.CommentSQL .Comment }}{{ end }};
+
+`
+}
+
+// SequenceName return sequence name for the column that has "sequence" tag.
+// table may be quoted by Quote(). It returns error if the server does not support sequence.
+func (m MariaDB) SequenceName(table, column string) (string, error) {
+	if !m.Version.AtLeast(10, 3) {
+		return "", fmt.Errorf("%w %s: sequence requires 10.3 or later", ErrNotSupportedVersion, m.Version)
+	}
+	return fmt.Sprintf("%s_%s_seq", strings.Trim(table, "`"), column), nil
+}
+
+// TagKeys return the MariaDB-specific "ddl" tag keys that TableTemplate uses.
+func (m MariaDB) TagKeys() []string {
+	return []string{"sequence", "invisible"}
+}
+
+// Invisible return INVISIBLE column attribute. It returns error if the server does not support it.
+func (m MariaDB) Invisible() (string, error) {
+	if !m.Version.AtLeast(10, 3) {
+		return "", fmt.Errorf("%w %s: invisible column requires 10.3 or later", ErrNotSupportedVersion, m.Version)
+	}
+	return "INVISIBLE", nil
+}
+
+// IsJSON return whether typeName is converted to JSON column.
+func (m MariaDB) IsJSON(typeName string) bool {
+	switch strings.TrimPrefix(typeName, "*") {
+	case "json.RawMessage", "json":
+		return true
+	}
+	return false
+}
+
+// ToSQL convert mariadb sql string from typeName and type options (e.g. size).
+// JSON is an alias for LONGTEXT in MariaDB. UUID (10.7 or later) and INET6 (10.5 or later)
+// are native types, and they fall back to CHAR(36) and VARCHAR(39) in older versions.
+func (m MariaDB) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if m.IsJSON(typeName) {
+		return "LONGTEXT", nil
+	}
+
+	switch typeName {
+	case "uuid", "uuid.UUID":
+		if m.Version.AtLeast(10, 7) {
+			return "UUID", nil
+		}
+		return "CHAR(36)", nil
+	case "inet6", "netip.Addr":
+		if m.Version.AtLeast(10, 5) {
+			return "INET6", nil
+		}
+		return "VARCHAR(39)", nil
+	case "inet4":
+		if m.Version.AtLeast(10, 10) {
+			return "INET4", nil
+		}
+		return "VARCHAR(15)", nil
+	default:
+		return m.MySQL.ToSQL(typeName, opt)
+	}
+}
+
+// FormatGenerated return the definition of the generated column. MariaDB does not
+// allow NULL / NOT NULL constraint on the generated column. STORED is written as
+// PERSISTENT before 10.2, and the column is VIRTUAL if storage is not specified.
+func (m MariaDB) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	definition := fmt.Sprintf("%s GENERATED ALWAYS AS (%s)", sqlType, expression)
+	switch {
+	case storage == sqltype.Stored && !m.Version.AtLeast(10, 2):
+		definition += " PERSISTENT"
+	case storage != sqltype.StorageDefault:
+		definition += " " + string(storage)
+	}
+	return definition, nil
+}
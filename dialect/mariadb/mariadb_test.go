@@ -0,0 +1,186 @@
+package mariadb
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestParseVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    Version
+		wantErr bool
+	}{
+		{
+			name:    "[Normal] empty is latest",
+			version: "",
+			want:    Version{},
+		},
+		{
+			name:    "[Normal] major and minor",
+			version: "10.6",
+			want:    Version{Major: 10, Minor: 6},
+		},
+		{
+			name:    "[Normal] version string from server",
+			version: "10.11.4-MariaDB-1:10.11.4+maria~ubu2204",
+			want:    Version{Major: 10, Minor: 11},
+		},
+		{
+			name:    "[Error] only major",
+			version: "10",
+			wantErr: true,
+		},
+		{
+			name:    "[Error] not number",
+			version: "10.x",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVersion(tt.version)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("mismatch want=%v, got=%v", ErrInvalidVersion, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersion_AtLeast(t *testing.T) {
+	if !(Version{}).AtLeast(10, 7) {
+		t.Error("zero value must be the latest version")
+	}
+	if !(Version{Major: 10, Minor: 11}).AtLeast(10, 7) {
+		t.Error("10.11 is later than 10.7")
+	}
+	if (Version{Major: 10, Minor: 6}).AtLeast(10, 7) {
+		t.Error("10.6 is older than 10.7")
+	}
+	if !(Version{Major: 11, Minor: 0}).AtLeast(10, 7) {
+		t.Error("11.0 is later than 10.7")
+	}
+}
+
+func TestMariaDB_ToSQL(t *testing.T) {
+	testcases := []struct {
+		version  Version
+		typeName string
+		output   string
+	}{
+		{Version{}, "json.RawMessage", "LONGTEXT"},
+		{Version{}, "*json.RawMessage", "LONGTEXT"},
+		{Version{}, "json", "LONGTEXT"},
+		{Version{}, "uuid", "UUID"},
+		{Version{Major: 10, Minor: 6}, "uuid", "CHAR(36)"},
+		{Version{}, "inet6", "INET6"},
+		{Version{Major: 10, Minor: 4}, "inet6", "VARCHAR(39)"},
+		{Version{}, "inet4", "INET4"},
+		{Version{Major: 10, Minor: 6}, "inet4", "VARCHAR(15)"},
+		{Version{}, "int64", "BIGINT"},
+		{Version{}, "string", "VARCHAR(191)"},
+	}
+
+	for _, tc := range testcases {
+		m := MariaDB{Version: tc.version}
+		got, err := m.ToSQL(tc.typeName, sqltype.Option{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s (%s). but result %s", tc.typeName, tc.output, tc.version, got)
+		}
+	}
+
+	m := MariaDB{}
+	if _, err := m.ToSQL("noExistType", sqltype.Option{}); !errors.Is(err, mysql.ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v", mysql.ErrInvalidType, err)
+	}
+}
+
+func TestMariaDB_SequenceName(t *testing.T) {
+	m := MariaDB{}
+	got, err := m.SequenceName("`ticket`", "number")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "ticket_number_seq" {
+		t.Errorf("MariaDB.SequenceName() = %v, want %v", got, "ticket_number_seq")
+	}
+
+	m = MariaDB{Version: Version{Major: 10, Minor: 2}}
+	if _, err := m.SequenceName("`ticket`", "number"); !errors.Is(err, ErrNotSupportedVersion) {
+		t.Errorf("mismatch want=%v, got=%v", ErrNotSupportedVersion, err)
+	}
+}
+
+func TestMariaDB_TagKeys(t *testing.T) {
+	want := []string{"sequence", "invisible"}
+	if got := (MariaDB{}).TagKeys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("MariaDB.TagKeys() = %v, want %v", got, want)
+	}
+}
+
+func TestMariaDB_Invisible(t *testing.T) {
+	m := MariaDB{}
+	got, err := m.Invisible()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "INVISIBLE" {
+		t.Errorf("MariaDB.Invisible() = %v, want %v", got, "INVISIBLE")
+	}
+
+	m = MariaDB{Version: Version{Major: 10, Minor: 2}}
+	if _, err := m.Invisible(); !errors.Is(err, ErrNotSupportedVersion) {
+		t.Errorf("mismatch want=%v, got=%v", ErrNotSupportedVersion, err)
+	}
+}
+
+func TestMariaDB_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		version Version
+		storage sqltype.Storage
+		want    string
+	}{
+		{
+			name: "[Normal] storage is not specified",
+			want: "INTEGER GENERATED ALWAYS AS (price * quantity)",
+		},
+		{
+			name:    "[Normal] stored",
+			storage: sqltype.Stored,
+			want:    "INTEGER GENERATED ALWAYS AS (price * quantity) STORED",
+		},
+		{
+			name:    "[Normal] stored is persistent before 10.2",
+			version: Version{Major: 10, Minor: 1},
+			storage: sqltype.Stored,
+			want:    "INTEGER GENERATED ALWAYS AS (price * quantity) PERSISTENT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := MariaDB{Version: tt.version}
+			got, err := m.FormatGenerated("INTEGER", "price * quantity", tt.storage, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("MariaDB.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
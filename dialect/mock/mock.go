@@ -1,5 +1,7 @@
 package mock
 
+import "github.com/nao1215/ddl-maker/dialect/sqltype"
+
 // SQLMock is struct for test
 type SQLMock struct {
 	Engine             string
@@ -24,8 +26,8 @@ func (mockSQL SQLMock) TableTemplate() string {
 	return mockSQL.MockTableTemplate()
 }
 
-// ToSQL convert mockSQL sql string from typeName and size
-func (mockSQL SQLMock) ToSQL(typeName string, size uint64) (string, error) {
+// ToSQL convert mockSQL sql string from typeName and type options (e.g. size)
+func (mockSQL SQLMock) ToSQL(typeName string, opt sqltype.Option) (string, error) {
 	return "", nil
 }
 
@@ -0,0 +1,461 @@
+package mssql
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+// ErrInvalidType means Invalid type specified when parsing
+var ErrInvalidType = errors.New("Specified type is invalid")
+
+const (
+	defaultNVarcharSize = 255
+	autoIncrement       = "IDENTITY(1,1)"
+)
+
+// SQLServer is a model for Microsoft SQL Server
+type SQLServer struct{}
+
+// HeaderTemplate return string that is sql header template
+func (ss SQLServer) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (ss SQLServer) FooterTemplate() string {
+	return ""
+}
+
+// TableTemplate return string that is sql table template.
+// DROP TABLE IF EXISTS is only available from SQL Server 2016, so the table is
+// dropped with OBJECT_ID() check. Each statement is separated with GO.
+func (ss SQLServer) TableTemplate() string {
+	return `
+IF OBJECT_ID(N'{{ .Name }}', N'U') IS NOT NULL
+    DROP TABLE {{ .Name }};
+GO
+
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);
+GO
+{{- if .Comment }}
+{{ .Dialect.DescriptionSQL .Name "" .Comment }}
+GO{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+{{ $.Dialect.DescriptionSQL $.Name .Name .Comment }}
+GO{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+GO
+{{ end -}}
+
+`
+}
+
+// ToSQL convert sql server sql string from typeName and type options (e.g. size)
+func (ss SQLServer) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	switch typeName {
+	case "int8", "*int8":
+		return "SMALLINT", nil
+	case "int16", "*int16", "sql.NullInt16":
+		return "SMALLINT", nil
+	case "int32", "*int32", "sql.NullInt32":
+		return "INT", nil
+	case "int64", "*int64", "sql.NullInt64":
+		return "BIGINT", nil
+	case "uint8", "*uint8", "sql.NullByte":
+		return "TINYINT", nil
+	case "uint16", "*uint16":
+		return "INT", nil
+	case "uint32", "*uint32":
+		return "BIGINT", nil
+	case "uint64", "*uint64":
+		return "BIGINT", nil
+	case "float32", "*float32":
+		return "REAL", nil
+	case "float64", "*float64", "sql.NullFloat64":
+		return "FLOAT", nil
+	case "decimal", "decimal.Decimal", "*decimal.Decimal", "big.Rat", "*big.Rat":
+		return "DECIMAL" + opt.PrecisionScale("(38,18)"), nil
+	case "string", "*string", "sql.NullString":
+		return nvarchar(opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return varbinary(opt.Size), nil
+	case "bool", "*bool", "sql.NullBool":
+		return "BIT", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "NVARCHAR(MAX)", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "VARBINARY(MAX)", nil
+	case "time":
+		return "TIME", nil
+	case "time.Time", "*time.Time", "sql.NullTime":
+		return datetime2(opt.Size), nil
+	case "date":
+		return "DATE", nil
+	case "json.RawMessage", "*json.RawMessage":
+		return "NVARCHAR(MAX)", nil
+	case "uuid":
+		return "UNIQUEIDENTIFIER", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// DescriptionSQL return the statement that adds MS_Description extended property
+// as the comment of the table (and the column if column is not empty). SQL Server
+// has no COMMENT clause. table may be quoted by Quote().
+func (ss SQLServer) DescriptionSQL(table, column, comment string) string {
+	sql := fmt.Sprintf("EXEC sp_addextendedproperty N'MS_Description', N%s, N'SCHEMA', N'dbo', N'TABLE', N%s",
+		query.SingleQuote(comment), query.SingleQuote(strings.Trim(table, "[]")))
+	if column != "" {
+		sql += fmt.Sprintf(", N'COLUMN', N%s", query.SingleQuote(column))
+	}
+	return sql + ";"
+}
+
+// Quote encloses the string with [].
+func (ss SQLServer) Quote(s string) string {
+	return query.Bracket(s)
+}
+
+// EnumCheck return CHECK constraint that restricts the column to the enum values.
+// SQL Server has no enum type.
+func (ss SQLServer) EnumCheck(column string, values []string) string {
+	return fmt.Sprintf("CHECK (%s IN (%s))", ss.Quote(column), query.SingleQuoteList(values))
+}
+
+// AutoIncrement return string for auto-increment setting
+func (ss SQLServer) AutoIncrement() string {
+	return autoIncrement
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (ss SQLServer) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (ss SQLServer) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+func (ss SQLServer) ForeignKeySQL(fk schema.ForeignKey) string {
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(),
+		WithUpdateForeignKeyOption(ForeignKeyOptionType(fk.UpdateOption())),
+		WithDeleteForeignKeyOption(ForeignKeyOptionType(fk.DeleteOption()))).ToSQL()
+}
+
+// PrimaryKey is a model for determining the primary key
+type PrimaryKey struct {
+	columns []string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(bracketAll(pk.columns), ", "))
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return query.Bracket(i.name)
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return query.Bracket(i.table)
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return bracketAll(i.columns)
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		i.Name(), i.Table(), strings.Join(i.Columns(), ", "))
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return query.Bracket(ui.name)
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return query.Bracket(ui.table)
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return bracketAll(ui.columns)
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
+		ui.Name(), ui.Table(), strings.Join(ui.Columns(), ", "))
+}
+
+// ForeignKey is a model for setting foreign key constraints
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+	updateOption       string
+	deleteOption       string
+}
+
+// ForeignKeyOptionType is string that means foreign key otion
+// https://learn.microsoft.com/en-us/sql/relational-databases/tables/primary-and-foreign-key-constraints
+type ForeignKeyOptionType string
+
+// ForeignKeyOptionCascade CASCADE
+var ForeignKeyOptionCascade ForeignKeyOptionType = "CASCADE"
+
+// ForeignKeyOptionSetNull SET NULL
+var ForeignKeyOptionSetNull ForeignKeyOptionType = "SET NULL"
+
+// ForeignKeyOptionRestrict RESTRICT. SQL Server does not have RESTRICT, it is treated as NO ACTION.
+var ForeignKeyOptionRestrict ForeignKeyOptionType = "RESTRICT"
+
+// ForeignKeyOptionNoAction NO ACTION
+var ForeignKeyOptionNoAction ForeignKeyOptionType = "NO ACTION"
+
+// ForeignKeyOptionSetDefault SET DEFAULT
+var ForeignKeyOptionSetDefault ForeignKeyOptionType = "SET DEFAULT"
+
+// String Stringer for ForeignKeyOptionType
+func (fkopt ForeignKeyOptionType) String() string {
+	return string(fkopt)
+}
+
+// ForeignKeyOption is an interface for controlling foreign key constraint options.
+type ForeignKeyOption interface {
+	Apply(*ForeignKey)
+}
+
+type withUpdateForeignKeyOption string
+
+// Apply apply foreign key constraint options for Update.
+func (o withUpdateForeignKeyOption) Apply(f *ForeignKey) {
+	f.updateOption = string(o)
+}
+
+// WithUpdateForeignKeyOption return query that is the foreign key constraint options for Update.
+func WithUpdateForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, and RESTRICT is not supported by SQL Server.
+	case ForeignKeyOptionRestrict, ForeignKeyOptionNoAction:
+		return withUpdateForeignKeyOption("")
+	}
+	return withUpdateForeignKeyOption(option)
+}
+
+type withDeleteForeignKeyOption string
+
+// Apply apply foreign key constraint options for Delete.
+func (o withDeleteForeignKeyOption) Apply(f *ForeignKey) {
+	f.deleteOption = string(o)
+}
+
+// WithDeleteForeignKeyOption return query that is the foreign key constraint options for Delete.
+func WithDeleteForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, and RESTRICT is not supported by SQL Server.
+	case ForeignKeyOptionRestrict, ForeignKeyOptionNoAction:
+		return withDeleteForeignKeyOption("")
+	}
+	return withDeleteForeignKeyOption(option)
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string, option ...ForeignKeyOption) ForeignKey {
+	foreignKey := ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+
+	for _, o := range option {
+		if o != nil {
+			o.Apply(&foreignKey)
+		}
+	}
+	return foreignKey
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return bracketAll(fk.foreignColumns)
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return query.Bracket(fk.referenceTableName)
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return bracketAll(fk.referenceColumns)
+}
+
+// UpdateOption return foreign key constraint option string for update
+func (fk ForeignKey) UpdateOption() string {
+	return fk.updateOption
+}
+
+// DeleteOption return foreign key constraint option string for delete
+func (fk ForeignKey) DeleteOption() string {
+	return fk.deleteOption
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	sql := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(fk.ForeignColumns(), ", "),
+		fk.ReferenceTableName(),
+		strings.Join(fk.ReferenceColumns(), ", "))
+	if fk.DeleteOption() != "" {
+		sql = sql + fmt.Sprintf(" ON DELETE %s", fk.DeleteOption())
+	}
+	if fk.UpdateOption() != "" {
+		sql = sql + fmt.Sprintf(" ON UPDATE %s", fk.UpdateOption())
+	}
+	return sql
+}
+
+func bracketAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.Bracket(s))
+	}
+	return quoted
+}
+
+func nvarchar(size uint64) string {
+	if size == 0 {
+		return fmt.Sprintf("NVARCHAR(%d)", defaultNVarcharSize)
+	}
+
+	return fmt.Sprintf("NVARCHAR(%d)", size)
+}
+
+func varbinary(size uint64) string {
+	if size == 0 {
+		return "VARBINARY(MAX)"
+	}
+
+	return fmt.Sprintf("VARBINARY(%d)", size)
+}
+
+func datetime2(size uint64) string {
+	if size == 0 {
+		return "DATETIME2"
+	}
+
+	return fmt.Sprintf("DATETIME2(%d)", size)
+}
+
+// FormatGenerated return the definition of the computed column. The computed column
+// has no SQL type, and NOT NULL constraint is allowed only for the PERSISTED column.
+func (ss SQLServer) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	definition := fmt.Sprintf("AS (%s)", expression)
+	if storage != sqltype.Stored {
+		return definition, nil
+	}
+	definition += " PERSISTED"
+	if !null {
+		definition += " NOT NULL"
+	}
+	return definition, nil
+}
+
+// StringLiteral return the Unicode string literal of s (N'...'),
+// because string column is NVARCHAR in SQL Server.
+func (ss SQLServer) StringLiteral(s string) string {
+	return "N" + query.SingleQuote(s)
+}
+
+// BoolLiteral return 1 or 0. Boolean column is BIT in SQL Server.
+func (ss SQLServer) BoolLiteral(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
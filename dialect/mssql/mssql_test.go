@@ -0,0 +1,355 @@
+package mssql
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestSQLServer_HeaderTemplate(t *testing.T) {
+	ss := SQLServer{}
+	if got := ss.HeaderTemplate(); got != "" {
+		t.Errorf("SQLServer.HeaderTemplate() = %v, want empty", got)
+	}
+}
+
+func TestSQLServer_FooterTemplate(t *testing.T) {
+	ss := SQLServer{}
+	if got := ss.FooterTemplate(); got != "" {
+		t.Errorf("SQLServer.FooterTemplate() = %v, want empty", got)
+	}
+}
+
+func TestSQLServer_TableTemplate(t *testing.T) {
+	ss := SQLServer{}
+	want := `
+IF OBJECT_ID(N'{{ .Name }}', N'U') IS NOT NULL
+    DROP TABLE {{ .Name }};
+GO
+
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);
+GO
+{{- if .Comment }}
+{{ .Dialect.DescriptionSQL .Name "" .Comment }}
+GO{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+{{ $.Dialect.DescriptionSQL $.Name .Name .Comment }}
+GO{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+GO
+{{ end -}}
+
+`
+	if diff := cmp.Diff(want, ss.TableTemplate()); diff != "" {
+		t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+	}
+}
+
+func TestSQLServer_ToSQL(t *testing.T) {
+	ss := SQLServer{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "BIT"},
+		{"*bool", 0, "BIT"},
+		{"sql.NullBool", 0, "BIT"},
+		{"int8", 0, "SMALLINT"},
+		{"int16", 0, "SMALLINT"},
+		{"int32", 0, "INT"},
+		{"sql.NullInt32", 0, "INT"},
+		{"int64", 0, "BIGINT"},
+		{"sql.NullInt64", 0, "BIGINT"},
+		{"uint8", 0, "TINYINT"},
+		{"uint16", 0, "INT"},
+		{"uint32", 0, "BIGINT"},
+		{"uint64", 0, "BIGINT"},
+		{"float32", 0, "REAL"},
+		{"float64", 0, "FLOAT"},
+		{"decimal.Decimal", 0, "DECIMAL(38,18)"},
+		{"big.Rat", 0, "DECIMAL(38,18)"},
+		{"string", 0, "NVARCHAR(255)"},
+		{"string", 10, "NVARCHAR(10)"},
+		{"sql.NullString", 10, "NVARCHAR(10)"},
+		{"[]uint8", 0, "VARBINARY(MAX)"},
+		{"[]uint8", 16, "VARBINARY(16)"},
+		{"text", 0, "NVARCHAR(MAX)"},
+		{"blob", 0, "VARBINARY(MAX)"},
+		{"time", 0, "TIME"},
+		{"time.Time", 0, "DATETIME2"},
+		{"time.Time", 3, "DATETIME2(3)"},
+		{"sql.NullTime", 0, "DATETIME2"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "NVARCHAR(MAX)"},
+		{"uuid", 0, "UNIQUEIDENTIFIER"},
+	}
+
+	for _, tc := range testcases {
+		got, err := ss.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	if _, err := ss.ToSQL("noExistType", sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+	}
+}
+
+func TestSQLServer_Quote(t *testing.T) {
+	ss := SQLServer{}
+	if got := ss.Quote("test"); got != "[test]" {
+		t.Errorf("SQLServer.Quote() = %v, want %v", got, "[test]")
+	}
+}
+
+func TestSQLServer_AutoIncrement(t *testing.T) {
+	ss := SQLServer{}
+	if got := ss.AutoIncrement(); got != "IDENTITY(1,1)" {
+		t.Errorf("SQLServer.AutoIncrement() = %v", got)
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id", "created_at")
+	if !reflect.DeepEqual(pk.Columns(), []string{"id", "created_at"}) {
+		t.Errorf("PrimaryKey.Columns() = %v", pk.Columns())
+	}
+	if pk.ToSQL() != "PRIMARY KEY ([id], [created_at])" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("player_entry_id_idx", "player", "player_id", "entry_id")
+	if index.ToSQL() != "CREATE INDEX [player_entry_id_idx] ON [player] ([player_id], [entry_id]);" {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddUniqueIndex(t *testing.T) {
+	index := AddUniqueIndex("player_id_uniq_idx", "player", "player_id")
+	if index.ToSQL() != "CREATE UNIQUE INDEX [player_id_uniq_idx] ON [player] ([player_id]);" {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fk   ForeignKey
+		want string
+	}{
+		{
+			name: "[Normal] no option",
+			fk:   AddForeignKey([]string{"player_id"}, []string{"id"}, "player"),
+			want: "FOREIGN KEY ([player_id]) REFERENCES [player] ([id])",
+		},
+		{
+			name: "[Normal] NO ACTION is omitted",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithUpdateForeignKeyOption(ForeignKeyOptionNoAction),
+				WithDeleteForeignKeyOption(ForeignKeyOptionNoAction)),
+			want: "FOREIGN KEY ([player_id]) REFERENCES [player] ([id])",
+		},
+		{
+			name: "[Normal] RESTRICT is omitted",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithDeleteForeignKeyOption(ForeignKeyOptionRestrict)),
+			want: "FOREIGN KEY ([player_id]) REFERENCES [player] ([id])",
+		},
+		{
+			name: "[Normal] SET NULL and CASCADE",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithUpdateForeignKeyOption(ForeignKeyOptionCascade),
+				WithDeleteForeignKeyOption(ForeignKeyOptionSetNull)),
+			want: "FOREIGN KEY ([player_id]) REFERENCES [player] ([id]) ON DELETE SET NULL ON UPDATE CASCADE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fk.ToSQL(); got != tt.want {
+				t.Errorf("ForeignKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServer_ToSQL_Decimal(t *testing.T) {
+	ss := SQLServer{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "DECIMAL(38,18)",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ss.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("SQLServer.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServer_EnumCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "[Normal] enum values",
+			values: []string{"active", "inactive"},
+			want:   "CHECK ([status] IN ('active', 'inactive'))",
+		},
+		{
+			name:   "[Normal] enum value has single quote",
+			values: []string{"it's"},
+			want:   "CHECK ([status] IN ('it''s'))",
+		},
+		{
+			name:   "[Normal] enum value has backslash",
+			values: []string{`C:\dir`},
+			want:   `CHECK ([status] IN ('C:\dir'))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := SQLServer{}
+			if got := ss.EnumCheck("status", tt.values); got != tt.want {
+				t.Errorf("SQLServer.EnumCheck() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServer_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage sqltype.Storage
+		null    bool
+		want    string
+	}{
+		{
+			name: "[Normal] not persisted column has no NOT NULL",
+			want: "AS (price * quantity)",
+		},
+		{
+			name:    "[Normal] persisted column can be NOT NULL",
+			storage: sqltype.Stored,
+			want:    "AS (price * quantity) PERSISTED NOT NULL",
+		},
+		{
+			name:    "[Normal] nullable persisted column",
+			storage: sqltype.Stored,
+			null:    true,
+			want:    "AS (price * quantity) PERSISTED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := SQLServer{}
+			got, err := ss.FormatGenerated("INT", "price * quantity", tt.storage, tt.null)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("SQLServer.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServer_StringLiteral(t *testing.T) {
+	ss := SQLServer{}
+	if got, want := ss.StringLiteral("it's"), "N'it''s'"; got != want {
+		t.Errorf("SQLServer.StringLiteral() = %v, want %v", got, want)
+	}
+}
+
+func TestSQLServer_DescriptionSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		table   string
+		column  string
+		comment string
+		want    string
+	}{
+		{
+			name:    "[Normal] table comment",
+			table:   "[journal]",
+			comment: `User's journals (C:\journals)`,
+			want:    `EXEC sp_addextendedproperty N'MS_Description', N'User''s journals (C:\journals)', N'SCHEMA', N'dbo', N'TABLE', N'journal';`,
+		},
+		{
+			name:    "[Normal] column comment",
+			table:   "[journal]",
+			column:  "title",
+			comment: "It's the title",
+			want:    "EXEC sp_addextendedproperty N'MS_Description', N'It''s the title', N'SCHEMA', N'dbo', N'TABLE', N'journal', N'COLUMN', N'title';",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := SQLServer{}
+			if got := ss.DescriptionSQL(tt.table, tt.column, tt.comment); got != tt.want {
+				t.Errorf("SQLServer.DescriptionSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLServer_BoolLiteral(t *testing.T) {
+	ss := SQLServer{}
+	if got := ss.BoolLiteral(true); got != "1" {
+		t.Errorf("SQLServer.BoolLiteral(true) = %v, want 1", got)
+	}
+	if got := ss.BoolLiteral(false); got != "0" {
+		t.Errorf("SQLServer.BoolLiteral(false) = %v, want 0", got)
+	}
+}
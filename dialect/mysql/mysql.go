@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
 )
 
 const (
@@ -150,14 +152,26 @@ CREATE TABLE {{ .Name }} (
     {{ range .ForeignKeys.Sort  -}}
         {{ .ToSQL }},
     {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
     {{ .PrimaryKey.ToSQL }}
-) ENGINE={{ .Dialect.Engine }} DEFAULT CHARACTER SET {{ .Dialect.Charset }};
+) ENGINE={{ .Dialect.Engine }} DEFAULT CHARACTER SET {{ .Dialect.Charset }}{{ if .Comment }} {{ .Dialect.CommentSQL .Comment }}{{ end }};
 
 `
 }
 
-// ToSQL convert mysql sql string from typeName and size
-func (mysql MySQL) ToSQL(typeName string, size uint64) (string, error) {
+// ToSQL convert mysql sql string from typeName and type options (e.g. size).
+// The enum column is converted to ENUM type.
+func (mysql MySQL) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if opt.IsEnum() {
+		literals := make([]string, 0, len(opt.Enum))
+		for _, v := range opt.Enum {
+			literals = append(literals, mysql.StringLiteral(v))
+		}
+		return fmt.Sprintf("ENUM(%s)", strings.Join(literals, ", ")), nil
+	}
+
 	switch typeName {
 	case "int8", "*int8":
 		return "TINYINT", nil
@@ -179,10 +193,12 @@ func (mysql MySQL) ToSQL(typeName string, size uint64) (string, error) {
 		return "FLOAT", nil
 	case "float64", "*float64", "sql.NullFloat64":
 		return "DOUBLE", nil
+	case "decimal", "decimal.Decimal", "*decimal.Decimal", "big.Rat", "*big.Rat":
+		return "DECIMAL" + opt.PrecisionScale("(65,30)"), nil
 	case "string", "*string", "sql.NullString":
-		return varchar(size), nil
+		return varchar(opt.Size), nil
 	case "[]uint8", "sql.RawBytes":
-		return varbinary(size), nil
+		return varbinary(opt.Size), nil
 	case "bool", "*bool", "sql.NullBool":
 		return "TINYINT(1)", nil
 	case "tinytext":
@@ -204,11 +220,11 @@ func (mysql MySQL) ToSQL(typeName string, size uint64) (string, error) {
 	case "time":
 		return "TIME", nil
 	case "time.Time", "*time.Time":
-		return datetime(size), nil
+		return datetime(opt.Size), nil
 	case "mysql.NullTime": // https://godoc.org/github.com/go-sql-driver/mysql#NullTime
-		return datetime(size), nil
+		return datetime(opt.Size), nil
 	case "sql.NullTime": // from Go 1.13
-		return datetime(size), nil
+		return datetime(opt.Size), nil
 	case "date":
 		return "DATE", nil
 	case "json.RawMessage", "*json.RawMessage":
@@ -225,11 +241,50 @@ func (mysql MySQL) Quote(s string) string {
 	return query.Quote(s)
 }
 
+// CommentSQL return COMMENT clause of the table and the column.
+func (mysql MySQL) CommentSQL(comment string) string {
+	return "COMMENT " + mysql.StringLiteral(comment)
+}
+
+// StringLiteral return the string literal of s.
+// Backslash is the escape character in MySQL string literal, so it is escaped too.
+func (mysql MySQL) StringLiteral(s string) string {
+	return query.SingleQuote(strings.ReplaceAll(s, `\`, `\\`))
+}
+
+// BoolLiteral return 1 or 0. Boolean column is TINYINT(1) in MySQL.
+func (mysql MySQL) BoolLiteral(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
 // AutoIncrement return string for auto-increment setting
 func (mysql MySQL) AutoIncrement() string {
 	return autoIncrement
 }
 
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (mysql MySQL) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (mysql MySQL) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+func (mysql MySQL) ForeignKeySQL(fk schema.ForeignKey) string {
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(),
+		WithUpdateForeignKeyOption(ForeignKeyOptionType(fk.UpdateOption())),
+		WithDeleteForeignKeyOption(ForeignKeyOptionType(fk.DeleteOption()))).ToSQL()
+}
+
 // Name return index name
 func (i Index) Name() string {
 	return i.name
@@ -240,6 +295,11 @@ func (i Index) Columns() []string {
 	return i.columns
 }
 
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
 // ToSQL return index sql string
 func (i Index) ToSQL() string {
 	var columnsStr []string
@@ -259,6 +319,11 @@ func (ui UniqueIndex) Columns() []string {
 	return ui.columns
 }
 
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
 // ToSQL return unique index sql string
 func (ui UniqueIndex) ToSQL() string {
 	var columnsStr []string
@@ -279,6 +344,11 @@ func (fi FullTextIndex) Columns() []string {
 	return fi.columns
 }
 
+// Unique return whether the index is unique index
+func (fi FullTextIndex) Unique() bool {
+	return false
+}
+
 // WithParser XXX
 func (fi FullTextIndex) WithParser(s string) FullTextIndex {
 	fi.parser = s
@@ -309,6 +379,11 @@ func (si SpatialIndex) Columns() []string {
 	return si.columns
 }
 
+// Unique return whether the index is unique index
+func (si SpatialIndex) Unique() bool {
+	return false
+}
+
 // ToSQL return unique index sql string
 func (si SpatialIndex) ToSQL() string {
 	var columnsStr []string
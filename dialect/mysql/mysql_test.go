@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 )
 
 func TestToSQL(t *testing.T) {
@@ -41,6 +42,8 @@ func TestToSQL(t *testing.T) {
 		{"float32", 0, "FLOAT"},
 		{"*float32", 0, "FLOAT"},
 		{"float64", 0, "DOUBLE"},
+		{"decimal.Decimal", 0, "DECIMAL(65,30)"},
+		{"big.Rat", 0, "DECIMAL(65,30)"},
 		{"*float64", 0, "DOUBLE"},
 		{"sql.NullFloat64", 0, "DOUBLE"},
 		{"string", 0, fmt.Sprintf("VARCHAR(%d)", defaultVarcharSize)},
@@ -70,7 +73,7 @@ func TestToSQL(t *testing.T) {
 	}
 
 	for _, tc := range testcases {
-		got, err := m.ToSQL(tc.typeName, tc.size)
+		got, err := m.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -92,8 +95,8 @@ func TestToSQL2(t *testing.T) {
 	}
 
 	for _, tc := range testcases {
-		_, got := m.ToSQL(tc.typeName, tc.size)
-		if !errors.As(got, &tc.output) {
+		_, got := m.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if !errors.Is(got, tc.output) {
 			t.Errorf("mismatch want=%v, got=%v", tc.output, got)
 		}
 	}
@@ -384,8 +387,11 @@ CREATE TABLE {{ .Name }} (
     {{ range .ForeignKeys.Sort  -}}
         {{ .ToSQL }},
     {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
     {{ .PrimaryKey.ToSQL }}
-) ENGINE={{ .Dialect.Engine }} DEFAULT CHARACTER SET {{ .Dialect.Charset }};
+) ENGINE={{ .Dialect.Engine }} DEFAULT CHARACTER SET {{ .Dialect.Charset }}{{ if .Comment }} {{ .Dialect.CommentSQL .Comment }}{{ end }};
 
 `,
 		},
@@ -913,3 +919,127 @@ func TestForeignKey_DeleteOption(t *testing.T) {
 		})
 	}
 }
+
+func TestMySQL_ToSQL_Decimal(t *testing.T) {
+	m := MySQL{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "DECIMAL(65,30)",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "DECIMAL(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := m.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("MySQL.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMySQL_ToSQL_Enum(t *testing.T) {
+	mysql := MySQL{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] enum",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "ENUM('active', 'inactive')",
+		},
+		{
+			name:     "[Normal] nullable enum",
+			typeName: "*string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     "ENUM('active', 'inactive')",
+		},
+		{
+			name:     "[Normal] enum value has single quote",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"it's"}},
+			want:     "ENUM('it''s')",
+		},
+		{
+			name:     "[Normal] enum value has backslash",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{`C:\dir`}},
+			want:     `ENUM('C:\\dir')`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := mysql.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMySQL_StringLiteral(t *testing.T) {
+	mysql := MySQL{}
+	if got, want := mysql.StringLiteral(`it's C:\dir`), `'it''s C:\\dir'`; got != want {
+		t.Errorf("MySQL.StringLiteral() = %v, want %v", got, want)
+	}
+}
+
+func TestMySQL_CommentSQL(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    string
+	}{
+		{name: "[Normal] comment", comment: "Journal ID", want: "COMMENT 'Journal ID'"},
+		{name: "[Normal] single quote is escaped", comment: "User's journals", want: "COMMENT 'User''s journals'"},
+		{name: "[Normal] backslash is escaped", comment: `C:\journals`, want: `COMMENT 'C:\\journals'`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mysql := MySQL{}
+			if got := mysql.CommentSQL(tt.comment); got != tt.want {
+				t.Errorf("MySQL.CommentSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMySQL_BoolLiteral(t *testing.T) {
+	mysql := MySQL{}
+	if got := mysql.BoolLiteral(true); got != "1" {
+		t.Errorf("MySQL.BoolLiteral(true) = %v, want 1", got)
+	}
+	if got := mysql.BoolLiteral(false); got != "0" {
+		t.Errorf("MySQL.BoolLiteral(false) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,501 @@
+package oracle
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrIdentifierTooLong means identifier exceeds the maximum length of Oracle Database
+	ErrIdentifierTooLong = errors.New("identifier is too long")
+	// ErrStoredGeneratedColumn means the stored generated column is specified
+	ErrStoredGeneratedColumn = errors.New("stored generated column is not supported")
+	// ErrInvalidVersion means server version can not be parsed
+	ErrInvalidVersion = errors.New("Oracle Database server version is invalid")
+)
+
+const (
+	defaultVarchar2Size = 255
+	// defaultMaxIdentifierLength is the limit from Oracle Database 12.2.
+	// Before 12.2, the limit is 30 bytes.
+	defaultMaxIdentifierLength = 128
+	legacyMaxIdentifierLength  = 30
+	autoIncrement              = "GENERATED BY DEFAULT AS IDENTITY"
+)
+
+// Oracle is a model for Oracle Database.
+// Identifiers are converted to upper case when they are quoted, so tables and
+// columns can be referenced without double quotation.
+type Oracle struct {
+	// MaxIdentifierLength is the maximum byte length of identifier.
+	// If it is 0, the limit of Oracle Database 12.2 (128 bytes) is used.
+	MaxIdentifierLength int
+}
+
+// MaxIdentifierLengthOf return the maximum byte length of identifier of the server
+// version (e.g. "12.1", "19c"). It is 30 bytes before 12.2, and 128 bytes from 12.2.
+// If version is empty, the latest version is assumed.
+func MaxIdentifierLengthOf(version string) (int, error) {
+	if version == "" {
+		return defaultMaxIdentifierLength, nil
+	}
+
+	elems := strings.SplitN(version, ".", 3)
+	major, err := strconv.Atoi(strings.TrimRightFunc(elems[0], unicode.IsLetter))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+	var minor int
+	if len(elems) > 1 {
+		if minor, err = strconv.Atoi(elems[1]); err != nil {
+			return 0, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+		}
+	}
+
+	if major < 12 || (major == 12 && minor < 2) {
+		return legacyMaxIdentifierLength, nil
+	}
+	return defaultMaxIdentifierLength, nil
+}
+
+// HeaderTemplate return string that is sql header template
+func (o Oracle) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (o Oracle) FooterTemplate() string {
+	return ""
+}
+
+// TableTemplate return string that is sql table template.
+// Oracle Database does not have DROP TABLE IF EXISTS (before 23c), so the table
+// is dropped in PL/SQL block that ignores ORA-00942 (table or view does not exist).
+func (o Oracle) TableTemplate() string {
+	return `
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE {{ .Name }} CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+}
+
+// ToSQL convert oracle sql string from typeName and type options (e.g. size)
+func (o Oracle) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	switch typeName {
+	case "int8", "*int8":
+		return "NUMBER(3)", nil
+	case "int16", "*int16", "sql.NullInt16":
+		return "NUMBER(5)", nil
+	case "int32", "*int32", "sql.NullInt32":
+		return "NUMBER(10)", nil
+	case "int64", "*int64", "sql.NullInt64":
+		return "NUMBER(19)", nil
+	case "uint8", "*uint8", "sql.NullByte":
+		return "NUMBER(3)", nil
+	case "uint16", "*uint16":
+		return "NUMBER(5)", nil
+	case "uint32", "*uint32":
+		return "NUMBER(10)", nil
+	case "uint64", "*uint64":
+		return "NUMBER(20)", nil
+	case "float32", "*float32":
+		return "BINARY_FLOAT", nil
+	case "float64", "*float64", "sql.NullFloat64":
+		return "BINARY_DOUBLE", nil
+	case "decimal", "decimal.Decimal", "*decimal.Decimal", "big.Rat", "*big.Rat":
+		return "NUMBER" + opt.PrecisionScale(""), nil
+	case "string", "*string", "sql.NullString":
+		return varchar2(opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return raw(opt.Size), nil
+	case "bool", "*bool", "sql.NullBool":
+		return "NUMBER(1)", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "CLOB", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "BLOB", nil
+	case "time.Time", "*time.Time", "sql.NullTime":
+		return timestamp(opt.Size), nil
+	case "date":
+		return "DATE", nil
+	case "json.RawMessage", "*json.RawMessage":
+		return "CLOB", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// Quote converts the string to upper case and encloses it with "".
+func (o Oracle) Quote(s string) string {
+	return quote(s)
+}
+
+// EnumCheck return CHECK constraint that restricts the column to the enum values.
+// Oracle has no enum type.
+func (o Oracle) EnumCheck(column string, values []string) string {
+	return fmt.Sprintf("CHECK (%s IN (%s))", o.Quote(column), query.SingleQuoteList(values))
+}
+
+// AutoIncrement return string for auto-increment setting
+func (o Oracle) AutoIncrement() string {
+	return autoIncrement
+}
+
+// FormatAttribute return column attributes in the order that Oracle Database requires.
+// DEFAULT and identity clause must be written before NULL / NOT NULL constraint.
+func (o Oracle) FormatAttribute(sqlType string, null bool, defaultValue *string, auto bool) (string, error) {
+	var attributes []string
+
+	if auto {
+		attributes = append(attributes, autoIncrement)
+	} else if defaultValue != nil {
+		attributes = append(attributes, "DEFAULT", *defaultValue)
+	}
+
+	if null {
+		attributes = append(attributes, "NULL")
+	} else {
+		attributes = append(attributes, "NOT NULL")
+	}
+
+	return strings.Join(attributes, " "), nil
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (o Oracle) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (o Oracle) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+// Oracle Database supports only ON DELETE CASCADE and ON DELETE SET NULL, so other actions are omitted.
+func (o Oracle) ForeignKeySQL(fk schema.ForeignKey) string {
+	var option ForeignKeyOption
+	switch schema.Action(fk.DeleteOption()) {
+	case schema.Cascade:
+		option = WithDeleteForeignKeyOption(ForeignKeyOptionCascade)
+	case schema.SetNull:
+		option = WithDeleteForeignKeyOption(ForeignKeyOptionSetNull)
+	}
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(), option).ToSQL()
+}
+
+// ValidateIdentifier returns error if the identifier exceeds the maximum length.
+func (o Oracle) ValidateIdentifier(name string) error {
+	max := o.MaxIdentifierLength
+	if max == 0 {
+		max = defaultMaxIdentifierLength
+	}
+	if len(name) > max {
+		return fmt.Errorf("%w: %s is %d bytes (max %d bytes)", ErrIdentifierTooLong, name, len(name), max)
+	}
+	return nil
+}
+
+// PrimaryKey is a model for determining the primary key
+type PrimaryKey struct {
+	columns []string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return i.name
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return i.table
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return i.columns
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		quote(i.name), quote(i.table), strings.Join(quoteAll(i.columns), ", "))
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return ui.name
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return ui.table
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return ui.columns
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
+		quote(ui.name), quote(ui.table), strings.Join(quoteAll(ui.columns), ", "))
+}
+
+// ForeignKey is a model for setting foreign key constraints
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+	deleteOption       string
+}
+
+// ForeignKeyOptionType is string that means foreign key otion.
+// Oracle Database supports only ON DELETE CASCADE and ON DELETE SET NULL.
+type ForeignKeyOptionType string
+
+// ForeignKeyOptionCascade CASCADE
+var ForeignKeyOptionCascade ForeignKeyOptionType = "CASCADE"
+
+// ForeignKeyOptionSetNull SET NULL
+var ForeignKeyOptionSetNull ForeignKeyOptionType = "SET NULL"
+
+// ForeignKeyOptionNoAction NO ACTION
+var ForeignKeyOptionNoAction ForeignKeyOptionType = "NO ACTION"
+
+// String Stringer for ForeignKeyOptionType
+func (fkopt ForeignKeyOptionType) String() string {
+	return string(fkopt)
+}
+
+// ForeignKeyOption is an interface for controlling foreign key constraint options.
+type ForeignKeyOption interface {
+	Apply(*ForeignKey)
+}
+
+type withDeleteForeignKeyOption string
+
+// Apply apply foreign key constraint options for Delete.
+func (o withDeleteForeignKeyOption) Apply(f *ForeignKey) {
+	f.deleteOption = string(o)
+}
+
+// WithDeleteForeignKeyOption return query that is the foreign key constraint options for Delete.
+func WithDeleteForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, so it is the same as omitting the ON DELETE clause.
+	case ForeignKeyOptionNoAction:
+		return withDeleteForeignKeyOption("")
+	}
+	return withDeleteForeignKeyOption(option)
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string, option ...ForeignKeyOption) ForeignKey {
+	foreignKey := ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+
+	for _, o := range option {
+		if o != nil {
+			o.Apply(&foreignKey)
+		}
+	}
+	return foreignKey
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return fk.foreignColumns
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return fk.referenceTableName
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return fk.referenceColumns
+}
+
+// UpdateOption return foreign key constraint option string for update.
+// Oracle Database does not support ON UPDATE, so it always returns empty string.
+func (fk ForeignKey) UpdateOption() string {
+	return ""
+}
+
+// DeleteOption return foreign key constraint option string for delete
+func (fk ForeignKey) DeleteOption() string {
+	return fk.deleteOption
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	sql := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(quoteAll(fk.foreignColumns), ", "),
+		quote(fk.referenceTableName),
+		strings.Join(quoteAll(fk.referenceColumns), ", "))
+	if fk.DeleteOption() != "" {
+		sql = sql + fmt.Sprintf(" ON DELETE %s", fk.DeleteOption())
+	}
+	return sql
+}
+
+func quote(s string) string {
+	return query.DoubleQuote(strings.ToUpper(s))
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, quote(s))
+	}
+	return quoted
+}
+
+func varchar2(size uint64) string {
+	if size == 0 {
+		return fmt.Sprintf("VARCHAR2(%d CHAR)", defaultVarchar2Size)
+	}
+
+	return fmt.Sprintf("VARCHAR2(%d CHAR)", size)
+}
+
+func raw(size uint64) string {
+	if size == 0 {
+		return "BLOB"
+	}
+
+	return fmt.Sprintf("RAW(%d)", size)
+}
+
+func timestamp(size uint64) string {
+	if size == 0 {
+		return "TIMESTAMP"
+	}
+
+	return fmt.Sprintf("TIMESTAMP(%d)", size)
+}
+
+// FormatGenerated return the definition of the virtual column. Oracle Database
+// does not support the stored generated column.
+func (o Oracle) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	if storage == sqltype.Stored {
+		return "", fmt.Errorf("%w: %s", ErrStoredGeneratedColumn, expression)
+	}
+	definition := fmt.Sprintf("%s GENERATED ALWAYS AS (%s) VIRTUAL", sqlType, expression)
+	if null {
+		return definition + " NULL", nil
+	}
+	return definition + " NOT NULL", nil
+}
+
+// BoolLiteral return 1 or 0. Boolean column is NUMBER(1) in Oracle Database.
+func (o Oracle) BoolLiteral(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,378 @@
+package oracle
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestOracle_TableTemplate(t *testing.T) {
+	o := Oracle{}
+	want := `
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE {{ .Name }} CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+	if diff := cmp.Diff(want, o.TableTemplate()); diff != "" {
+		t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+	}
+}
+
+func TestOracle_ToSQL(t *testing.T) {
+	o := Oracle{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "NUMBER(1)"},
+		{"sql.NullBool", 0, "NUMBER(1)"},
+		{"int8", 0, "NUMBER(3)"},
+		{"int16", 0, "NUMBER(5)"},
+		{"int32", 0, "NUMBER(10)"},
+		{"sql.NullInt32", 0, "NUMBER(10)"},
+		{"int64", 0, "NUMBER(19)"},
+		{"*int64", 0, "NUMBER(19)"},
+		{"sql.NullInt64", 0, "NUMBER(19)"},
+		{"uint8", 0, "NUMBER(3)"},
+		{"uint16", 0, "NUMBER(5)"},
+		{"uint32", 0, "NUMBER(10)"},
+		{"uint64", 0, "NUMBER(20)"},
+		{"float32", 0, "BINARY_FLOAT"},
+		{"float64", 0, "BINARY_DOUBLE"},
+		{"decimal.Decimal", 0, "NUMBER"},
+		{"big.Rat", 0, "NUMBER"},
+		{"string", 0, "VARCHAR2(255 CHAR)"},
+		{"string", 10, "VARCHAR2(10 CHAR)"},
+		{"sql.NullString", 10, "VARCHAR2(10 CHAR)"},
+		{"[]uint8", 0, "BLOB"},
+		{"[]uint8", 16, "RAW(16)"},
+		{"text", 0, "CLOB"},
+		{"blob", 0, "BLOB"},
+		{"time.Time", 0, "TIMESTAMP"},
+		{"time.Time", 6, "TIMESTAMP(6)"},
+		{"sql.NullTime", 0, "TIMESTAMP"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "CLOB"},
+	}
+
+	for _, tc := range testcases {
+		got, err := o.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	if _, err := o.ToSQL("noExistType", sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+	}
+}
+
+func TestOracle_Quote(t *testing.T) {
+	o := Oracle{}
+	if got := o.Quote("player_id"); got != `"PLAYER_ID"` {
+		t.Errorf("Oracle.Quote() = %v, want %v", got, `"PLAYER_ID"`)
+	}
+}
+
+func TestOracle_FormatAttribute(t *testing.T) {
+	zero := "0"
+	tests := []struct {
+		name         string
+		null         bool
+		defaultValue *string
+		auto         bool
+		want         string
+	}{
+		{
+			name: "[Normal] not null",
+			want: "NOT NULL",
+		},
+		{
+			name: "[Normal] null",
+			null: true,
+			want: "NULL",
+		},
+		{
+			name:         "[Normal] default is written before NOT NULL",
+			defaultValue: &zero,
+			want:         "DEFAULT 0 NOT NULL",
+		},
+		{
+			name: "[Normal] identity is written before NOT NULL",
+			auto: true,
+			want: "GENERATED BY DEFAULT AS IDENTITY NOT NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Oracle{}
+			got, err := o.FormatAttribute("NUMBER(19)", tt.null, tt.defaultValue, tt.auto)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Oracle.FormatAttribute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOracle_ValidateIdentifier(t *testing.T) {
+	tests := []struct {
+		name    string
+		oracle  Oracle
+		id      string
+		wantErr bool
+	}{
+		{
+			name:    "[Normal] 128 bytes is valid",
+			oracle:  Oracle{},
+			id:      strings.Repeat("a", 128),
+			wantErr: false,
+		},
+		{
+			name:    "[Error] 129 bytes is too long",
+			oracle:  Oracle{},
+			id:      strings.Repeat("a", 129),
+			wantErr: true,
+		},
+		{
+			name:    "[Error] 31 bytes is too long before Oracle 12.2",
+			oracle:  Oracle{MaxIdentifierLength: 30},
+			id:      strings.Repeat("a", 31),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.oracle.ValidateIdentifier(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Oracle.ValidateIdentifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrIdentifierTooLong) {
+				t.Errorf("mismatch want=%v, got=%v", ErrIdentifierTooLong, err)
+			}
+		})
+	}
+}
+
+func TestMaxIdentifierLengthOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    int
+		wantErr error
+	}{
+		{name: "[Normal] latest version", version: "", want: 128},
+		{name: "[Normal] before 12.2", version: "11.2.0.4", want: 30},
+		{name: "[Normal] 12.1", version: "12.1", want: 30},
+		{name: "[Normal] 12.2", version: "12.2", want: 128},
+		{name: "[Normal] version with suffix", version: "19c", want: 128},
+		{name: "[Error] invalid major version", version: "twelve", wantErr: ErrInvalidVersion},
+		{name: "[Error] invalid minor version", version: "12.x", wantErr: ErrInvalidVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MaxIdentifierLengthOf(tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("MaxIdentifierLengthOf() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id", "created_at")
+	if !reflect.DeepEqual(pk.Columns(), []string{"id", "created_at"}) {
+		t.Errorf("PrimaryKey.Columns() = %v", pk.Columns())
+	}
+	if pk.ToSQL() != `PRIMARY KEY ("ID", "CREATED_AT")` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("player_entry_id_idx", "player", "player_id", "entry_id")
+	if index.Name() != "player_entry_id_idx" {
+		t.Errorf("Index.Name() = %v", index.Name())
+	}
+	if index.ToSQL() != `CREATE INDEX "PLAYER_ENTRY_ID_IDX" ON "PLAYER" ("PLAYER_ID", "ENTRY_ID");` {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddUniqueIndex(t *testing.T) {
+	index := AddUniqueIndex("player_id_uniq_idx", "player", "player_id")
+	if index.ToSQL() != `CREATE UNIQUE INDEX "PLAYER_ID_UNIQ_IDX" ON "PLAYER" ("PLAYER_ID");` {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fk   ForeignKey
+		want string
+	}{
+		{
+			name: "[Normal] no option",
+			fk:   AddForeignKey([]string{"player_id"}, []string{"id"}, "player"),
+			want: `FOREIGN KEY ("PLAYER_ID") REFERENCES "PLAYER" ("ID")`,
+		},
+		{
+			name: "[Normal] NO ACTION is omitted",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithDeleteForeignKeyOption(ForeignKeyOptionNoAction)),
+			want: `FOREIGN KEY ("PLAYER_ID") REFERENCES "PLAYER" ("ID")`,
+		},
+		{
+			name: "[Normal] ON DELETE SET NULL",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithDeleteForeignKeyOption(ForeignKeyOptionSetNull)),
+			want: `FOREIGN KEY ("PLAYER_ID") REFERENCES "PLAYER" ("ID") ON DELETE SET NULL`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fk.ToSQL(); got != tt.want {
+				t.Errorf("ForeignKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOracle_ToSQL_Decimal(t *testing.T) {
+	o := Oracle{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "NUMBER",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMBER(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMBER(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := o.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Oracle.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOracle_EnumCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "[Normal] enum values",
+			values: []string{"active", "inactive"},
+			want:   `CHECK ("STATUS" IN ('active', 'inactive'))`,
+		},
+		{
+			name:   "[Normal] enum value has single quote",
+			values: []string{"it's"},
+			want:   `CHECK ("STATUS" IN ('it''s'))`,
+		},
+		{
+			name:   "[Normal] enum value has backslash",
+			values: []string{`C:\dir`},
+			want:   `CHECK ("STATUS" IN ('C:\dir'))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := Oracle{}
+			if got := o.EnumCheck("status", tt.values); got != tt.want {
+				t.Errorf("Oracle.EnumCheck() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOracle_FormatGenerated(t *testing.T) {
+	o := Oracle{}
+	got, err := o.FormatGenerated("NUMBER(10)", "price * quantity", sqltype.StorageDefault, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "NUMBER(10) GENERATED ALWAYS AS (price * quantity) VIRTUAL NULL"; got != want {
+		t.Errorf("Oracle.FormatGenerated() = %v, want %v", got, want)
+	}
+
+	if _, err := o.FormatGenerated("NUMBER(10)", "price * quantity", sqltype.Stored, true); !errors.Is(err, ErrStoredGeneratedColumn) {
+		t.Errorf("mismatch want=%v, got=%v", ErrStoredGeneratedColumn, err)
+	}
+}
+
+func TestOracle_BoolLiteral(t *testing.T) {
+	o := Oracle{}
+	if got := o.BoolLiteral(true); got != "1" {
+		t.Errorf("Oracle.BoolLiteral(true) = %v, want 1", got)
+	}
+	if got := o.BoolLiteral(false); got != "0" {
+		t.Errorf("Oracle.BoolLiteral(false) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,461 @@
+package postgres
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrVirtualGeneratedColumn means the server version does not support the virtual generated column
+	ErrVirtualGeneratedColumn = errors.New("virtual generated column requires PostgreSQL 18 or later")
+	// ErrInvalidVersion means the server version can not be parsed
+	ErrInvalidVersion = errors.New("PostgreSQL server version is invalid")
+)
+
+const (
+	autoIncrement = "GENERATED BY DEFAULT AS IDENTITY"
+	// virtualGeneratedVersion is the major version that supports the virtual generated column.
+	virtualGeneratedVersion = 18
+)
+
+// PostgreSQL is a model for PostgreSQL
+type PostgreSQL struct {
+	// MajorVersion is the major version of the server (e.g. 16). Zero means the version
+	// is not specified, and the features of the older versions are used.
+	MajorVersion int
+}
+
+// ParseMajorVersion return the major version of the server version (e.g. 16 of "16.2",
+// 18 of "18beta1"). If version is empty, return 0.
+func ParseMajorVersion(version string) (int, error) {
+	if version == "" {
+		return 0, nil
+	}
+
+	major := strings.SplitN(version, ".", 2)[0]
+	if i := strings.IndexFunc(major, func(r rune) bool { return r < '0' || r > '9' }); i >= 0 {
+		major = major[:i]
+	}
+	v, err := strconv.Atoi(major)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidVersion, version)
+	}
+	return v, nil
+}
+
+// HeaderTemplate return string that is sql header template
+func (pg PostgreSQL) HeaderTemplate() string {
+	return `BEGIN;
+`
+}
+
+// FooterTemplate return string that is sql footer template
+func (pg PostgreSQL) FooterTemplate() string {
+	return `COMMIT;
+`
+}
+
+// TableTemplate return string that is sql table template.
+// PostgreSQL can not define indexes in CREATE TABLE statement, so indexes are
+// created after the table. The enum types are created before the table.
+func (pg PostgreSQL) TableTemplate() string {
+	return `
+DROP TABLE IF EXISTS {{ .Name }} CASCADE;
+{{ range .Columns }}{{ if .EnumValues -}}
+DROP TYPE IF EXISTS {{ $.Dialect.Quote .EnumName }};
+CREATE TYPE {{ $.Dialect.Quote .EnumName }} AS ENUM ({{ .EnumLiterals }});
+{{ end }}{{ end }}
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+}
+
+// ToSQL convert postgres sql string from typeName and type options (e.g. size).
+// The enum column is converted to the enum type created by TableTemplate.
+func (pg PostgreSQL) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if opt.IsEnum() {
+		return pg.Quote(opt.EnumName), nil
+	}
+
+	switch typeName {
+	case "int8", "*int8":
+		return "SMALLINT", nil
+	case "int16", "*int16", "sql.NullInt16":
+		return "SMALLINT", nil
+	case "int32", "*int32", "sql.NullInt32":
+		return "INTEGER", nil
+	case "int64", "*int64", "sql.NullInt64":
+		return "BIGINT", nil
+	case "uint8", "*uint8", "sql.NullByte":
+		return "SMALLINT", nil
+	case "uint16", "*uint16":
+		return "INTEGER", nil
+	case "uint32", "*uint32":
+		return "BIGINT", nil
+	case "uint64", "*uint64":
+		return "BIGINT", nil
+	case "float32", "*float32":
+		return "REAL", nil
+	case "float64", "*float64", "sql.NullFloat64":
+		return "DOUBLE PRECISION", nil
+	case "decimal", "decimal.Decimal", "*decimal.Decimal", "big.Rat", "*big.Rat":
+		return "NUMERIC" + opt.PrecisionScale(""), nil
+	case "string", "*string", "sql.NullString":
+		return varchar(opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return "BYTEA", nil
+	case "bool", "*bool", "sql.NullBool":
+		return "BOOLEAN", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "TEXT", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "BYTEA", nil
+	case "time":
+		return "TIME", nil
+	case "time.Time", "*time.Time", "sql.NullTime":
+		return timestamptz(opt.Size), nil
+	case "date":
+		return "DATE", nil
+	case "json.RawMessage", "*json.RawMessage":
+		return "JSONB", nil
+	case "uuid":
+		return "UUID", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// Quote encloses the string with "".
+func (pg PostgreSQL) Quote(s string) string {
+	return query.DoubleQuote(s)
+}
+
+// AutoIncrement return string for auto-increment setting
+func (pg PostgreSQL) AutoIncrement() string {
+	return autoIncrement
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (pg PostgreSQL) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (pg PostgreSQL) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+func (pg PostgreSQL) ForeignKeySQL(fk schema.ForeignKey) string {
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(),
+		WithUpdateForeignKeyOption(ForeignKeyOptionType(fk.UpdateOption())),
+		WithDeleteForeignKeyOption(ForeignKeyOptionType(fk.DeleteOption()))).ToSQL()
+}
+
+// PrimaryKey is a model for determining the primary key
+type PrimaryKey struct {
+	columns []string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return query.DoubleQuote(i.name)
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return query.DoubleQuote(i.table)
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return quoteAll(i.columns)
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		i.Name(), i.Table(), strings.Join(i.Columns(), ", "))
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return query.DoubleQuote(ui.name)
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return query.DoubleQuote(ui.table)
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return quoteAll(ui.columns)
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
+		ui.Name(), ui.Table(), strings.Join(ui.Columns(), ", "))
+}
+
+// ForeignKey is a model for setting foreign key constraints
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+	updateOption       string
+	deleteOption       string
+}
+
+// ForeignKeyOptionType is string that means foreign key otion
+// https://www.postgresql.org/docs/current/ddl-constraints.html#DDL-CONSTRAINTS-FK
+type ForeignKeyOptionType string
+
+// ForeignKeyOptionCascade CASCADE
+var ForeignKeyOptionCascade ForeignKeyOptionType = "CASCADE"
+
+// ForeignKeyOptionSetNull SET NULL
+var ForeignKeyOptionSetNull ForeignKeyOptionType = "SET NULL"
+
+// ForeignKeyOptionRestrict RESTRICT
+var ForeignKeyOptionRestrict ForeignKeyOptionType = "RESTRICT"
+
+// ForeignKeyOptionNoAction NO ACTION
+var ForeignKeyOptionNoAction ForeignKeyOptionType = "NO ACTION"
+
+// ForeignKeyOptionSetDefault SET DEFAULT
+var ForeignKeyOptionSetDefault ForeignKeyOptionType = "SET DEFAULT"
+
+// String Stringer for ForeignKeyOptionType
+func (fkopt ForeignKeyOptionType) String() string {
+	return string(fkopt)
+}
+
+// ForeignKeyOption is an interface for controlling foreign key constraint options.
+type ForeignKeyOption interface {
+	Apply(*ForeignKey)
+}
+
+type withUpdateForeignKeyOption string
+
+// Apply apply foreign key constraint options for Update.
+func (o withUpdateForeignKeyOption) Apply(f *ForeignKey) {
+	f.updateOption = string(o)
+}
+
+// WithUpdateForeignKeyOption return query that is the foreign key constraint options for Update.
+func WithUpdateForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, so it is the same as omitting the ON UPDATE clause.
+	// RESTRICT is not omitted because it can not be deferred unlike NO ACTION.
+	case ForeignKeyOptionNoAction:
+		return withUpdateForeignKeyOption("")
+	}
+	return withUpdateForeignKeyOption(option)
+}
+
+type withDeleteForeignKeyOption string
+
+// Apply apply foreign key constraint options for Delete.
+func (o withDeleteForeignKeyOption) Apply(f *ForeignKey) {
+	f.deleteOption = string(o)
+}
+
+// WithDeleteForeignKeyOption return query that is the foreign key constraint options for Delete.
+func WithDeleteForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, so it is the same as omitting the ON DELETE clause.
+	// RESTRICT is not omitted because it can not be deferred unlike NO ACTION.
+	case ForeignKeyOptionNoAction:
+		return withDeleteForeignKeyOption("")
+	}
+	return withDeleteForeignKeyOption(option)
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string, option ...ForeignKeyOption) ForeignKey {
+	foreignKey := ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+
+	for _, o := range option {
+		if o != nil {
+			o.Apply(&foreignKey)
+		}
+	}
+	return foreignKey
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return quoteAll(fk.foreignColumns)
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return query.DoubleQuote(fk.referenceTableName)
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return quoteAll(fk.referenceColumns)
+}
+
+// UpdateOption return foreign key constraint option string for update
+func (fk ForeignKey) UpdateOption() string {
+	return fk.updateOption
+}
+
+// DeleteOption return foreign key constraint option string for delete
+func (fk ForeignKey) DeleteOption() string {
+	return fk.deleteOption
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	sql := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(fk.ForeignColumns(), ", "),
+		fk.ReferenceTableName(),
+		strings.Join(fk.ReferenceColumns(), ", "))
+	if fk.DeleteOption() != "" {
+		sql = sql + fmt.Sprintf(" ON DELETE %s", fk.DeleteOption())
+	}
+	if fk.UpdateOption() != "" {
+		sql = sql + fmt.Sprintf(" ON UPDATE %s", fk.UpdateOption())
+	}
+	return sql
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.DoubleQuote(s))
+	}
+	return quoted
+}
+
+func varchar(size uint64) string {
+	if size == 0 {
+		return "TEXT"
+	}
+
+	return fmt.Sprintf("VARCHAR(%d)", size)
+}
+
+func timestamptz(size uint64) string {
+	if size == 0 {
+		return "TIMESTAMPTZ"
+	}
+
+	return fmt.Sprintf("TIMESTAMPTZ(%d)", size)
+}
+
+// FormatGenerated return the definition of the generated column. The column is
+// STORED if storage is not specified. VIRTUAL is supported by PostgreSQL 18 or later,
+// so it is rejected unless MajorVersion is 18 or later.
+func (pg PostgreSQL) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	switch storage {
+	case sqltype.StorageDefault:
+		storage = sqltype.Stored
+	case sqltype.Virtual:
+		if pg.MajorVersion < virtualGeneratedVersion {
+			return "", fmt.Errorf("%w: %s", ErrVirtualGeneratedColumn, expression)
+		}
+	}
+	definition := fmt.Sprintf("%s GENERATED ALWAYS AS (%s) %s", sqlType, expression, storage)
+	if null {
+		return definition + " NULL", nil
+	}
+	return definition + " NOT NULL", nil
+}
@@ -0,0 +1,349 @@
+package postgres
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestPostgreSQL_HeaderTemplate(t *testing.T) {
+	pg := PostgreSQL{}
+	want := `BEGIN;
+`
+	if got := pg.HeaderTemplate(); got != want {
+		t.Errorf("PostgreSQL.HeaderTemplate() = %v, want %v", got, want)
+	}
+}
+
+func TestPostgreSQL_FooterTemplate(t *testing.T) {
+	pg := PostgreSQL{}
+	want := `COMMIT;
+`
+	if got := pg.FooterTemplate(); got != want {
+		t.Errorf("PostgreSQL.FooterTemplate() = %v, want %v", got, want)
+	}
+}
+
+func TestPostgreSQL_TableTemplate(t *testing.T) {
+	pg := PostgreSQL{}
+	want := `
+DROP TABLE IF EXISTS {{ .Name }} CASCADE;
+{{ range .Columns }}{{ if .EnumValues -}}
+DROP TYPE IF EXISTS {{ $.Dialect.Quote .EnumName }};
+CREATE TYPE {{ $.Dialect.Quote .EnumName }} AS ENUM ({{ .EnumLiterals }});
+{{ end }}{{ end }}
+CREATE TABLE {{ .Name }} (
+    {{ range .Columns -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .ForeignKeys.Sort  -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
+    {{ .PrimaryKey.ToSQL }}
+);{{ if .Comment }}
+COMMENT ON TABLE {{ .Name }} IS {{ .QuotedComment }};{{ end }}
+{{- range .Columns }}{{ if .Comment }}
+COMMENT ON COLUMN {{ $.Name }}.{{ $.Dialect.Quote .Name }} IS {{ .QuotedComment }};{{ end }}{{ end }}
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+	if diff := cmp.Diff(want, pg.TableTemplate()); diff != "" {
+		t.Errorf("Compare value is mismatch (-want +got):%s\n", diff)
+	}
+}
+
+func TestPostgreSQL_ToSQL(t *testing.T) {
+	pg := PostgreSQL{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "BOOLEAN"},
+		{"*bool", 0, "BOOLEAN"},
+		{"sql.NullBool", 0, "BOOLEAN"},
+		{"int8", 0, "SMALLINT"},
+		{"int16", 0, "SMALLINT"},
+		{"sql.NullInt16", 0, "SMALLINT"},
+		{"int32", 0, "INTEGER"},
+		{"sql.NullInt32", 0, "INTEGER"},
+		{"int64", 0, "BIGINT"},
+		{"*int64", 0, "BIGINT"},
+		{"sql.NullInt64", 0, "BIGINT"},
+		{"uint8", 0, "SMALLINT"},
+		{"uint16", 0, "INTEGER"},
+		{"uint32", 0, "BIGINT"},
+		{"uint64", 0, "BIGINT"},
+		{"float32", 0, "REAL"},
+		{"float64", 0, "DOUBLE PRECISION"},
+		{"decimal.Decimal", 0, "NUMERIC"},
+		{"big.Rat", 0, "NUMERIC"},
+		{"sql.NullFloat64", 0, "DOUBLE PRECISION"},
+		{"string", 0, "TEXT"},
+		{"string", 10, "VARCHAR(10)"},
+		{"sql.NullString", 10, "VARCHAR(10)"},
+		{"[]uint8", 0, "BYTEA"},
+		{"sql.RawBytes", 0, "BYTEA"},
+		{"text", 0, "TEXT"},
+		{"longtext", 0, "TEXT"},
+		{"blob", 0, "BYTEA"},
+		{"time", 0, "TIME"},
+		{"time.Time", 0, "TIMESTAMPTZ"},
+		{"time.Time", 6, "TIMESTAMPTZ(6)"},
+		{"sql.NullTime", 0, "TIMESTAMPTZ"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "JSONB"},
+		{"uuid", 0, "UUID"},
+	}
+
+	for _, tc := range testcases {
+		got, err := pg.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	if _, err := pg.ToSQL("noExistType", sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+	}
+}
+
+func TestPostgreSQL_Quote(t *testing.T) {
+	pg := PostgreSQL{}
+	if got := pg.Quote("test"); got != `"test"` {
+		t.Errorf("PostgreSQL.Quote() = %v, want %v", got, `"test"`)
+	}
+}
+
+func TestPostgreSQL_AutoIncrement(t *testing.T) {
+	pg := PostgreSQL{}
+	if got := pg.AutoIncrement(); got != "GENERATED BY DEFAULT AS IDENTITY" {
+		t.Errorf("PostgreSQL.AutoIncrement() = %v", got)
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id", "created_at")
+	if !reflect.DeepEqual(pk.Columns(), []string{"id", "created_at"}) {
+		t.Errorf("PrimaryKey.Columns() = %v", pk.Columns())
+	}
+	if pk.ToSQL() != `PRIMARY KEY ("id", "created_at")` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("player_entry_id_idx", "player", "player_id", "entry_id")
+	if index.ToSQL() != `CREATE INDEX "player_entry_id_idx" ON "player" ("player_id", "entry_id");` {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddUniqueIndex(t *testing.T) {
+	index := AddUniqueIndex("player_id_uniq_idx", "player", "player_id")
+	if index.ToSQL() != `CREATE UNIQUE INDEX "player_id_uniq_idx" ON "player" ("player_id");` {
+		t.Errorf("UniqueIndex.ToSQL() = %v", index.ToSQL())
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fk   ForeignKey
+		want string
+	}{
+		{
+			name: "[Normal] no option",
+			fk:   AddForeignKey([]string{"player_id"}, []string{"id"}, "player"),
+			want: `FOREIGN KEY ("player_id") REFERENCES "player" ("id")`,
+		},
+		{
+			name: "[Normal] NO ACTION is omitted",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithUpdateForeignKeyOption(ForeignKeyOptionNoAction),
+				WithDeleteForeignKeyOption(ForeignKeyOptionNoAction)),
+			want: `FOREIGN KEY ("player_id") REFERENCES "player" ("id")`,
+		},
+		{
+			name: "[Normal] RESTRICT and CASCADE",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				WithUpdateForeignKeyOption(ForeignKeyOptionCascade),
+				WithDeleteForeignKeyOption(ForeignKeyOptionRestrict)),
+			want: `FOREIGN KEY ("player_id") REFERENCES "player" ("id") ON DELETE RESTRICT ON UPDATE CASCADE`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fk.ToSQL(); got != tt.want {
+				t.Errorf("ForeignKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgreSQL_ToSQL_Decimal(t *testing.T) {
+	pg := PostgreSQL{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "NUMERIC",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pg.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("PostgreSQL.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgreSQL_ToSQL_Enum(t *testing.T) {
+	pg := PostgreSQL{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] enum",
+			typeName: "string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     `"users_status"`,
+		},
+		{
+			name:     "[Normal] nullable enum",
+			typeName: "*string",
+			opt:      sqltype.Option{Enum: []string{"active", "inactive"}, EnumName: "users_status"},
+			want:     `"users_status"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pg.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("mismatch want=%s, got=%s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestParseMajorVersion(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+		want    int
+		wantErr error
+	}{
+		{name: "[Normal] empty version", version: "", want: 0},
+		{name: "[Normal] major version", version: "16", want: 16},
+		{name: "[Normal] major and minor version", version: "17.4", want: 17},
+		{name: "[Normal] beta version", version: "18beta1", want: 18},
+		{name: "[Normal] legacy version", version: "9.6.24", want: 9},
+		{name: "[Error] invalid version", version: "latest", wantErr: ErrInvalidVersion},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMajorVersion(tt.version)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseMajorVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPostgreSQL_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		pg      PostgreSQL
+		storage sqltype.Storage
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "[Normal] stored by default",
+			pg:      PostgreSQL{},
+			storage: sqltype.StorageDefault,
+			want:    "BIGINT GENERATED ALWAYS AS (price * quantity) STORED NOT NULL",
+		},
+		{
+			name:    "[Normal] stored before 18",
+			pg:      PostgreSQL{MajorVersion: 17},
+			storage: sqltype.Stored,
+			want:    "BIGINT GENERATED ALWAYS AS (price * quantity) STORED NOT NULL",
+		},
+		{
+			name:    "[Normal] virtual from 18",
+			pg:      PostgreSQL{MajorVersion: 18},
+			storage: sqltype.Virtual,
+			want:    "BIGINT GENERATED ALWAYS AS (price * quantity) VIRTUAL NOT NULL",
+		},
+		{
+			name:    "[Error] virtual before 18",
+			pg:      PostgreSQL{MajorVersion: 17},
+			storage: sqltype.Virtual,
+			wantErr: ErrVirtualGeneratedColumn,
+		},
+		{
+			name:    "[Error] virtual of unknown version",
+			pg:      PostgreSQL{},
+			storage: sqltype.Virtual,
+			wantErr: ErrVirtualGeneratedColumn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.pg.FormatGenerated("BIGINT", "price * quantity", tt.storage, false)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("PostgreSQL.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
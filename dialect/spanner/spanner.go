@@ -0,0 +1,472 @@
+package spanner
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
+)
+
+var (
+	// ErrInvalidType means Invalid type specified when parsing
+	ErrInvalidType = errors.New("Specified type is invalid")
+	// ErrAutoIncrementNotSupported means "auto" tag is specified, but Spanner has no auto-increment column.
+	ErrAutoIncrementNotSupported = errors.New("Spanner does not support auto-increment column (use UUID or bit-reversed sequence for key instead)")
+)
+
+// nullTypes is the mapping of sql.Null* types to the type they wrap.
+var nullTypes = map[string]string{
+	"sql.NullByte":    "uint8",
+	"sql.NullInt16":   "int16",
+	"sql.NullInt32":   "int32",
+	"sql.NullInt64":   "int64",
+	"sql.NullFloat64": "float64",
+	"sql.NullString":  "string",
+	"sql.NullBool":    "bool",
+	"sql.NullTime":    "time.Time",
+}
+
+// literalEscaper escapes the special characters in the string literal.
+var literalEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`)
+
+// Spanner is a model for Google Cloud Spanner (GoogleSQL dialect)
+type Spanner struct{}
+
+// HeaderTemplate return string that is sql header template
+func (s Spanner) HeaderTemplate() string {
+	return ""
+}
+
+// FooterTemplate return string that is sql footer template
+func (s Spanner) FooterTemplate() string {
+	return ""
+}
+
+// DropTemplate return string that is sql template to drop the table. Spanner can not drop
+// the parent table of the interleaved table, so all tables are dropped in reverse order
+// (child first) before the tables are created. Spanner can not drop the table that has
+// indexes, so the indexes are dropped first.
+func (s Spanner) DropTemplate() string {
+	return `{{ range .Indexes.Sort -}}
+DROP INDEX IF EXISTS {{ $.Dialect.Quote .Name }};
+{{ end -}}
+DROP TABLE IF EXISTS {{ .Name }};
+`
+}
+
+// TableTemplate return string that is sql table template. The table is dropped by DropTemplate.
+// PRIMARY KEY (and INTERLEAVE IN PARENT clause) is written after the column list.
+// The parent table must be declared before the interleaved table.
+// Spanner has no enum type, so the enum values are restricted by table-level CHECK constraint.
+func (s Spanner) TableTemplate() string {
+	return `
+{{ if .Comment }}{{ .Dialect.LineComment .Comment }}
+{{ end }}CREATE TABLE {{ .Name }} (
+    {{ range $i, $c := .Columns -}}
+        {{ if $i }},
+    {{ end }}{{ if $c.Comment }}{{ $.Dialect.LineComment $c.Comment }}
+    {{ end }}{{ $c.ToSQL }}
+    {{- end }}
+    {{- range .ForeignKeys.Sort -}},
+    {{ .ToSQL }}
+    {{- end }}
+    {{- range .Columns }}{{ if .EnumValues }},
+    CHECK ({{ $.Dialect.Quote .Name }} IN ({{ .EnumLiterals }}))
+    {{- end }}{{ end }}
+    {{- range .Checks }},
+    {{ .ToSQL }}
+    {{- end }}
+) {{ if .PrimaryKey }}{{ .PrimaryKey.ToSQL }}{{ else }}PRIMARY KEY (){{ end }};
+
+{{ range .Indexes.Sort -}}
+    {{ .ToSQL }}
+{{ end -}}
+
+`
+}
+
+// ToSQL convert spanner sql string from typeName and type options (e.g. size).
+// Go slices (except []byte) are converted to ARRAY<T>.
+func (s Spanner) ToSQL(typeName string, opt sqltype.Option) (string, error) {
+	if strings.HasPrefix(typeName, "*") {
+		return s.ToSQL(strings.TrimPrefix(typeName, "*"), opt)
+	}
+	if t, ok := nullTypes[typeName]; ok {
+		return s.ToSQL(t, opt)
+	}
+	if strings.HasPrefix(typeName, "[]") && typeName != "[]uint8" {
+		elem, err := s.ToSQL(strings.TrimPrefix(typeName, "[]"), opt)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasPrefix(elem, "ARRAY<") {
+			// Spanner does not support array of array.
+			return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+		}
+		return fmt.Sprintf("ARRAY<%s>", elem), nil
+	}
+
+	switch typeName {
+	case "int8", "int16", "int32", "int64", "int":
+		return "INT64", nil
+	case "uint8", "uint16", "uint32", "uint64", "uint":
+		return "INT64", nil
+	case "float32":
+		return "FLOAT32", nil
+	case "float64":
+		return "FLOAT64", nil
+	case "string":
+		return varLength("STRING", opt.Size), nil
+	case "[]uint8", "sql.RawBytes":
+		return varLength("BYTES", opt.Size), nil
+	case "bool":
+		return "BOOL", nil
+	case "tinytext", "text", "mediumtext", "longtext":
+		return "STRING(MAX)", nil
+	case "tinyblob", "blob", "mediumblob", "longblob":
+		return "BYTES(MAX)", nil
+	case "time.Time", "timestamp":
+		return "TIMESTAMP", nil
+	case "date", "civil.Date":
+		return "DATE", nil
+	case "json.RawMessage", "json", "spanner.NullJSON":
+		return "JSON", nil
+	case "numeric", "decimal", "decimal.Decimal", "big.Rat", "spanner.NullNumeric":
+		// NUMERIC of Spanner has fixed precision (38) and scale (9).
+		return "NUMERIC", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidType, typeName)
+	}
+}
+
+// LineComment return SQL comment of the table and the column. Spanner has no
+// COMMENT clause, so the comment is written as "--" comment.
+func (s Spanner) LineComment(comment string) string {
+	return "-- " + strings.Join(strings.Fields(comment), " ")
+}
+
+// StringLiteral return the string literal of s. GoogleSQL escapes the quote,
+// the backslash and the newline in the string literal by backslash.
+func (s Spanner) StringLiteral(str string) string {
+	return "'" + literalEscaper.Replace(str) + "'"
+}
+
+// Quote encloses the string with backquotes.
+func (s Spanner) Quote(str string) string {
+	return query.Quote(str)
+}
+
+// AutoIncrement return empty string because Spanner has no auto-increment.
+func (s Spanner) AutoIncrement() string {
+	return ""
+}
+
+// FormatAttribute return column attributes for Spanner.
+// Spanner has no NULL keyword and the default value must be enclosed in parentheses.
+// It returns ErrAutoIncrementNotSupported if "auto" tag is specified.
+func (s Spanner) FormatAttribute(sqlType string, null bool, defaultValue *string, auto bool) (string, error) {
+	if auto {
+		return "", ErrAutoIncrementNotSupported
+	}
+
+	var attributes []string
+	if !null {
+		attributes = append(attributes, "NOT NULL")
+	}
+	if defaultValue != nil {
+		attributes = append(attributes, fmt.Sprintf("DEFAULT (%s)", *defaultValue))
+	}
+
+	return strings.Join(attributes, " "), nil
+}
+
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (s Spanner) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (s Spanner) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+// Spanner supports only ON DELETE CASCADE, so other actions are omitted.
+func (s Spanner) ForeignKeySQL(fk schema.ForeignKey) string {
+	var option ForeignKeyOption
+	if schema.Action(fk.DeleteOption()) == schema.Cascade {
+		option = WithDeleteForeignKeyOption(ForeignKeyOptionCascade)
+	}
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(), option).ToSQL()
+}
+
+// PrimaryKey is a model for determining the primary key.
+// It also has the parent table if the table is interleaved.
+type PrimaryKey struct {
+	columns  []string
+	parent   string
+	onDelete string
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// WithInterleaveInParent set parent table that the table is interleaved in.
+// The primary key of the table must start with the primary key columns of the parent table.
+// ForeignKeyOptionNoAction is the default behavior, so it is omitted.
+func (pk PrimaryKey) WithInterleaveInParent(parent string, onDelete ForeignKeyOptionType) PrimaryKey {
+	pk.parent = parent
+	pk.onDelete = ""
+	if onDelete != ForeignKeyOptionNoAction {
+		pk.onDelete = onDelete.String()
+	}
+	return pk
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// Parent return parent table name. If the table is not interleaved, return empty string.
+func (pk PrimaryKey) Parent() string {
+	return pk.parent
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	sql := fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+	if pk.parent == "" {
+		return sql
+	}
+
+	sql += fmt.Sprintf(",\n  INTERLEAVE IN PARENT %s", query.Quote(pk.parent))
+	if pk.onDelete != "" {
+		sql += fmt.Sprintf(" ON DELETE %s", pk.onDelete)
+	}
+	return sql
+}
+
+// Index is model representing indexes to speed up DB searches
+type Index struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName, table string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return i.name
+}
+
+// Table return table name
+func (i Index) Table() string {
+	return i.table
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return i.columns
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
+		query.Quote(i.name), query.Quote(i.table), strings.Join(quoteAll(i.columns), ", "))
+}
+
+// UniqueIndex is model that represents unique constraints
+type UniqueIndex struct {
+	columns []string
+	table   string
+	name    string
+}
+
+// AddUniqueIndex returns a new UniqueIndex
+func AddUniqueIndex(idxName, table string, columns ...string) UniqueIndex {
+	return UniqueIndex{
+		name:    idxName,
+		table:   table,
+		columns: columns,
+	}
+}
+
+// Name return unique index name
+func (ui UniqueIndex) Name() string {
+	return ui.name
+}
+
+// Table return table name
+func (ui UniqueIndex) Table() string {
+	return ui.table
+}
+
+// Columns return unique index columns
+func (ui UniqueIndex) Columns() []string {
+	return ui.columns
+}
+
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
+// ToSQL return unique index sql string
+func (ui UniqueIndex) ToSQL() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
+		query.Quote(ui.name), query.Quote(ui.table), strings.Join(quoteAll(ui.columns), ", "))
+}
+
+// ForeignKey is a model for setting foreign key constraints
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+	deleteOption       string
+}
+
+// ForeignKeyOptionType is string that means foreign key otion.
+// Spanner supports only ON DELETE CASCADE and ON DELETE NO ACTION.
+type ForeignKeyOptionType string
+
+// ForeignKeyOptionCascade CASCADE
+var ForeignKeyOptionCascade ForeignKeyOptionType = "CASCADE"
+
+// ForeignKeyOptionNoAction NO ACTION
+var ForeignKeyOptionNoAction ForeignKeyOptionType = "NO ACTION"
+
+// String Stringer for ForeignKeyOptionType
+func (fkopt ForeignKeyOptionType) String() string {
+	return string(fkopt)
+}
+
+// ForeignKeyOption is an interface for controlling foreign key constraint options.
+type ForeignKeyOption interface {
+	Apply(*ForeignKey)
+}
+
+type withDeleteForeignKeyOption string
+
+// Apply apply foreign key constraint options for Delete.
+func (o withDeleteForeignKeyOption) Apply(f *ForeignKey) {
+	f.deleteOption = string(o)
+}
+
+// WithDeleteForeignKeyOption return query that is the foreign key constraint options for Delete.
+func WithDeleteForeignKeyOption(option ForeignKeyOptionType) ForeignKeyOption {
+	switch option {
+	// NO ACTION is the default behavior, so it is the same as omitting the ON DELETE clause.
+	case ForeignKeyOptionNoAction:
+		return withDeleteForeignKeyOption("")
+	}
+	return withDeleteForeignKeyOption(option)
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string, option ...ForeignKeyOption) ForeignKey {
+	foreignKey := ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+
+	for _, o := range option {
+		if o != nil {
+			o.Apply(&foreignKey)
+		}
+	}
+	return foreignKey
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return fk.foreignColumns
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return fk.referenceTableName
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return fk.referenceColumns
+}
+
+// UpdateOption return foreign key constraint option string for update.
+// Spanner does not support ON UPDATE, so it always returns empty string.
+func (fk ForeignKey) UpdateOption() string {
+	return ""
+}
+
+// DeleteOption return foreign key constraint option string for delete
+func (fk ForeignKey) DeleteOption() string {
+	return fk.deleteOption
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	sql := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(quoteAll(fk.foreignColumns), ", "),
+		query.Quote(fk.referenceTableName),
+		strings.Join(quoteAll(fk.referenceColumns), ", "))
+	if fk.DeleteOption() != "" {
+		sql = sql + fmt.Sprintf(" ON DELETE %s", fk.DeleteOption())
+	}
+	return sql
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.Quote(s))
+	}
+	return quoted
+}
+
+// varLength return STRING(n) or BYTES(n). If size is 0, the length is MAX.
+func varLength(typeName string, size uint64) string {
+	if size == 0 {
+		return fmt.Sprintf("%s(MAX)", typeName)
+	}
+
+	return fmt.Sprintf("%s(%d)", typeName, size)
+}
+
+// FormatGenerated return the definition of the generated column.
+// NOT NULL constraint is written before the expression in Spanner.
+func (s Spanner) FormatGenerated(sqlType, expression string, storage sqltype.Storage, null bool) (string, error) {
+	definition := sqlType
+	if !null {
+		definition += " NOT NULL"
+	}
+	definition += fmt.Sprintf(" AS (%s)", expression)
+	if storage == sqltype.Stored {
+		definition += " STORED"
+	}
+	return definition, nil
+}
@@ -0,0 +1,292 @@
+package spanner
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
+)
+
+func TestSpanner_ToSQL(t *testing.T) {
+	s := Spanner{}
+
+	testcases := []struct {
+		typeName string
+		size     uint64
+		output   string
+	}{
+		{"bool", 0, "BOOL"},
+		{"*bool", 0, "BOOL"},
+		{"sql.NullBool", 0, "BOOL"},
+		{"int8", 0, "INT64"},
+		{"int32", 0, "INT64"},
+		{"int64", 0, "INT64"},
+		{"*int64", 0, "INT64"},
+		{"sql.NullInt64", 0, "INT64"},
+		{"uint64", 0, "INT64"},
+		{"float32", 0, "FLOAT32"},
+		{"float64", 0, "FLOAT64"},
+		{"decimal.Decimal", 0, "NUMERIC"},
+		{"big.Rat", 0, "NUMERIC"},
+		{"sql.NullFloat64", 0, "FLOAT64"},
+		{"string", 0, "STRING(MAX)"},
+		{"string", 36, "STRING(36)"},
+		{"sql.NullString", 0, "STRING(MAX)"},
+		{"text", 0, "STRING(MAX)"},
+		{"[]uint8", 0, "BYTES(MAX)"},
+		{"[]uint8", 16, "BYTES(16)"},
+		{"blob", 0, "BYTES(MAX)"},
+		{"time.Time", 0, "TIMESTAMP"},
+		{"*time.Time", 0, "TIMESTAMP"},
+		{"sql.NullTime", 0, "TIMESTAMP"},
+		{"date", 0, "DATE"},
+		{"json.RawMessage", 0, "JSON"},
+		{"numeric", 0, "NUMERIC"},
+		{"[]string", 0, "ARRAY<STRING(MAX)>"},
+		{"[]string", 10, "ARRAY<STRING(10)>"},
+		{"[]int64", 0, "ARRAY<INT64>"},
+		{"[]*int64", 0, "ARRAY<INT64>"},
+	}
+
+	for _, tc := range testcases {
+		got, err := s.ToSQL(tc.typeName, sqltype.Option{Size: tc.size})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tc.output {
+			t.Fatalf("error %s to sql %s. but result %s", tc.typeName, tc.output, got)
+		}
+	}
+
+	for _, typeName := range []string{"noExistType", "[]noExistType", "[][]string"} {
+		if _, err := s.ToSQL(typeName, sqltype.Option{}); !errors.Is(err, ErrInvalidType) {
+			t.Errorf("mismatch want=%v, got=%v", ErrInvalidType, err)
+		}
+	}
+}
+
+func TestSpanner_FormatAttribute(t *testing.T) {
+	now := "CURRENT_TIMESTAMP()"
+	tests := []struct {
+		name         string
+		null         bool
+		defaultValue *string
+		auto         bool
+		want         string
+		wantErr      error
+	}{
+		{
+			name: "[Normal] not null",
+			want: "NOT NULL",
+		},
+		{
+			name: "[Normal] NULL keyword is omitted",
+			null: true,
+			want: "",
+		},
+		{
+			name:         "[Normal] default is enclosed in parentheses",
+			defaultValue: &now,
+			want:         "NOT NULL DEFAULT (CURRENT_TIMESTAMP())",
+		},
+		{
+			name:    "[Error] auto-increment is not supported",
+			auto:    true,
+			wantErr: ErrAutoIncrementNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Spanner{}
+			got, err := s.FormatAttribute("INT64", tt.null, tt.defaultValue, tt.auto)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("Spanner.FormatAttribute() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	tests := []struct {
+		name string
+		pk   PrimaryKey
+		want string
+	}{
+		{
+			name: "[Normal] primary key",
+			pk:   AddPrimaryKey("singer_id"),
+			want: "PRIMARY KEY (`singer_id`)",
+		},
+		{
+			name: "[Normal] interleave in parent",
+			pk: AddPrimaryKey("singer_id", "album_id").
+				WithInterleaveInParent("singer", ForeignKeyOptionNoAction),
+			want: "PRIMARY KEY (`singer_id`, `album_id`),\n  INTERLEAVE IN PARENT `singer`",
+		},
+		{
+			name: "[Normal] interleave in parent on delete cascade",
+			pk: AddPrimaryKey("singer_id", "album_id").
+				WithInterleaveInParent("singer", ForeignKeyOptionCascade),
+			want: "PRIMARY KEY (`singer_id`, `album_id`),\n  INTERLEAVE IN PARENT `singer` ON DELETE CASCADE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pk.ToSQL(); got != tt.want {
+				t.Errorf("PrimaryKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	index := AddIndex("album_title_idx", "album", "title")
+	if index.ToSQL() != "CREATE INDEX `album_title_idx` ON `album` (`title`);" {
+		t.Errorf("Index.ToSQL() = %v", index.ToSQL())
+	}
+
+	uniqueIndex := AddUniqueIndex("singer_email_uniq_idx", "singer", "email")
+	if uniqueIndex.ToSQL() != "CREATE UNIQUE INDEX `singer_email_uniq_idx` ON `singer` (`email`);" {
+		t.Errorf("UniqueIndex.ToSQL() = %v", uniqueIndex.ToSQL())
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fk   ForeignKey
+		want string
+	}{
+		{
+			name: "[Normal] no option",
+			fk:   AddForeignKey([]string{"label_id"}, []string{"id"}, "label"),
+			want: "FOREIGN KEY (`label_id`) REFERENCES `label` (`id`)",
+		},
+		{
+			name: "[Normal] NO ACTION is omitted",
+			fk: AddForeignKey([]string{"label_id"}, []string{"id"}, "label",
+				WithDeleteForeignKeyOption(ForeignKeyOptionNoAction)),
+			want: "FOREIGN KEY (`label_id`) REFERENCES `label` (`id`)",
+		},
+		{
+			name: "[Normal] ON DELETE CASCADE",
+			fk: AddForeignKey([]string{"label_id"}, []string{"id"}, "label",
+				WithDeleteForeignKeyOption(ForeignKeyOptionCascade)),
+			want: "FOREIGN KEY (`label_id`) REFERENCES `label` (`id`) ON DELETE CASCADE",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fk.ToSQL(); got != tt.want {
+				t.Errorf("ForeignKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanner_ToSQL_Decimal(t *testing.T) {
+	s := Spanner{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "NUMERIC",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := s.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Spanner.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanner_StringLiteral(t *testing.T) {
+	s := Spanner{}
+	if got, want := s.StringLiteral("it's C:\\dir\n"), `'it\'s C:\\dir\n'`; got != want {
+		t.Errorf("Spanner.StringLiteral() = %v, want %v", got, want)
+	}
+}
+
+func TestSpanner_LineComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    string
+	}{
+		{name: "[Normal] comment", comment: "It's the title", want: "-- It's the title"},
+		{name: "[Normal] line breaks are folded", comment: "first line\nsecond line", want: "-- first line second line"},
+		{name: "[Normal] spaces are squeezed", comment: "  Journal   ID  ", want: "-- Journal ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Spanner{}
+			if got := s.LineComment(tt.comment); got != tt.want {
+				t.Errorf("Spanner.LineComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpanner_FormatGenerated(t *testing.T) {
+	tests := []struct {
+		name    string
+		storage sqltype.Storage
+		null    bool
+		want    string
+	}{
+		{
+			name: "[Normal] NOT NULL is written before AS",
+			want: "INT64 NOT NULL AS (price * quantity)",
+		},
+		{
+			name:    "[Normal] stored column",
+			storage: sqltype.Stored,
+			want:    "INT64 NOT NULL AS (price * quantity) STORED",
+		},
+		{
+			name:    "[Normal] nullable stored column",
+			storage: sqltype.Stored,
+			null:    true,
+			want:    "INT64 AS (price * quantity) STORED",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := Spanner{}
+			got, err := s.FormatGenerated("INT64", "price * quantity", tt.storage, tt.null)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Spanner.FormatGenerated() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 	"github.com/nao1215/ddl-maker/query"
+	"github.com/nao1215/ddl-maker/schema"
 )
 
 // ErrInvalidType means Invalid type specified when parsing
@@ -36,13 +38,18 @@ func (sqlite SQLite) TableTemplate() string {
 	return `
 DROP TABLE IF EXISTS {{ .Name }};
 
-CREATE TABLE {{ .Name }} (
+{{ if .Comment }}{{ .Dialect.LineComment .Comment }}
+{{ end }}CREATE TABLE {{ .Name }} (
     {{ range .Columns -}}
-        {{ .ToSQL }},
+        {{ if .Comment }}{{ $.Dialect.LineComment .Comment }}
+    {{ end }}{{ .ToSQL }},
     {{ end -}}
     {{ range .ForeignKeys.Sort  -}}
         {{ .ToSQL }},
     {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
     {{ .PrimaryKey.ToSQL }}
 );
 
@@ -53,8 +60,8 @@ CREATE TABLE {{ .Name }} (
 `
 }
 
-// ToSQL convert sqlite sql string from typeName and size
-func (sqlite SQLite) ToSQL(typeName string, size uint64) (string, error) {
+// ToSQL convert sqlite sql string from typeName and type options (e.g. size)
+func (sqlite SQLite) ToSQL(typeName string, opt sqltype.Option) (string, error) {
 	switch typeName {
 	case "int8", "*int8":
 		return "INTEGER", nil
@@ -76,6 +83,8 @@ func (sqlite SQLite) ToSQL(typeName string, size uint64) (string, error) {
 		return "REAL", nil
 	case "float64", "*float64", "sql.NullFloat64":
 		return "REAL", nil
+	case "decimal", "decimal.Decimal", "*decimal.Decimal", "big.Rat", "*big.Rat":
+		return "NUMERIC" + opt.PrecisionScale(""), nil
 	case "string", "*string", "sql.NullString":
 		return "TEXT", nil
 	case "[]uint8", "sql.RawBytes":
@@ -113,16 +122,48 @@ func (sqlite SQLite) ToSQL(typeName string, size uint64) (string, error) {
 	}
 }
 
+// LineComment return SQL comment of the table and the column. SQLite has no
+// COMMENT clause, so the comment is written as "--" comment.
+func (sqlite SQLite) LineComment(comment string) string {
+	return "-- " + strings.Join(strings.Fields(comment), " ")
+}
+
 // Quote return string that encloses with ``.
 func (sqlite SQLite) Quote(s string) string {
 	return query.Quote(s)
 }
 
+// EnumCheck return CHECK constraint that restricts the column to the enum values.
+// SQLite has no enum type.
+func (sqlite SQLite) EnumCheck(column string, values []string) string {
+	return fmt.Sprintf("CHECK (%s IN (%s))", sqlite.Quote(column), query.SingleQuoteList(values))
+}
+
 // AutoIncrement return string for auto-increment setting
 func (sqlite SQLite) AutoIncrement() string {
 	return autoIncrement
 }
 
+// PrimaryKeySQL return primary key sql string of dialect-agnostic primary key.
+func (sqlite SQLite) PrimaryKeySQL(pk schema.PrimaryKey) string {
+	return AddPrimaryKey(pk.Columns()...).ToSQL()
+}
+
+// IndexSQL return index sql string of dialect-agnostic index.
+func (sqlite SQLite) IndexSQL(table string, index schema.Index) string {
+	if index.Unique() {
+		return AddUniqueIndex(index.Name(), table, index.Columns()...).ToSQL()
+	}
+	return AddIndex(index.Name(), table, index.Columns()...).ToSQL()
+}
+
+// ForeignKeySQL return foreign key sql string of dialect-agnostic foreign key.
+func (sqlite SQLite) ForeignKeySQL(fk schema.ForeignKey) string {
+	return AddForeignKey(fk.ForeignColumns(), fk.ReferenceColumns(), fk.ReferenceTableName(),
+		WithUpdateForeignKeyOption(ForeignKeyOptionType(fk.UpdateOption())),
+		WithDeleteForeignKeyOption(ForeignKeyOptionType(fk.DeleteOption()))).ToSQL()
+}
+
 // PrimaryKey is a model for determining the primary key
 type PrimaryKey struct {
 	columns []string
@@ -184,6 +225,11 @@ func (i Index) Columns() []string {
 	return columnsStr
 }
 
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return false
+}
+
 // ToSQL return index sql string
 func (i Index) ToSQL() string {
 	return fmt.Sprintf("CREATE INDEX %s ON %s (%s);",
@@ -225,6 +271,11 @@ func (ui UniqueIndex) Columns() []string {
 	return columnsStr
 }
 
+// Unique return whether the index is unique index
+func (ui UniqueIndex) Unique() bool {
+	return true
+}
+
 // ToSQL return unique unique index sql string
 func (ui UniqueIndex) ToSQL() string {
 	return fmt.Sprintf("CREATE UNIQUE INDEX %s ON %s (%s);",
@@ -366,3 +417,11 @@ func (fk ForeignKey) ToSQL() string {
 	}
 	return sql
 }
+
+// BoolLiteral return 1 or 0. Boolean column is INTEGER in SQLite.
+func (sqlite SQLite) BoolLiteral(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -3,6 +3,8 @@ package sqlite
 import (
 	"reflect"
 	"testing"
+
+	"github.com/nao1215/ddl-maker/dialect/sqltype"
 )
 
 func TestSQLite_HeaderTemplate(t *testing.T) {
@@ -63,13 +65,18 @@ func TestSQLite_TableTemplate(t *testing.T) {
 			want: `
 DROP TABLE IF EXISTS {{ .Name }};
 
-CREATE TABLE {{ .Name }} (
+{{ if .Comment }}{{ .Dialect.LineComment .Comment }}
+{{ end }}CREATE TABLE {{ .Name }} (
     {{ range .Columns -}}
-        {{ .ToSQL }},
+        {{ if .Comment }}{{ $.Dialect.LineComment .Comment }}
+    {{ end }}{{ .ToSQL }},
     {{ end -}}
     {{ range .ForeignKeys.Sort  -}}
         {{ .ToSQL }},
     {{ end -}}
+    {{ range .Checks -}}
+        {{ .ToSQL }},
+    {{ end -}}
     {{ .PrimaryKey.ToSQL }}
 );
 
@@ -520,7 +527,7 @@ func TestSQLite_ToSQL(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			sqlite := SQLite{}
-			got, err := sqlite.ToSQL(tt.args.typeName, tt.args.size)
+			got, err := sqlite.ToSQL(tt.args.typeName, sqltype.Option{Size: tt.args.size})
 			if (err != nil) != tt.wantErr {
 				t.Errorf("SQLite.ToSQL() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -1314,3 +1321,105 @@ func TestForeignKey_ToSQL(t *testing.T) {
 		})
 	}
 }
+
+func TestSQLite_ToSQL_Decimal(t *testing.T) {
+	sqlite := SQLite{}
+
+	tests := []struct {
+		name     string
+		typeName string
+		opt      sqltype.Option
+		want     string
+	}{
+		{
+			name:     "[Normal] decimal.Decimal without precision",
+			typeName: "decimal.Decimal",
+			want:     "NUMERIC",
+		},
+		{
+			name:     "[Normal] decimal.Decimal with precision and scale",
+			typeName: "decimal.Decimal",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC(12,2)",
+		},
+		{
+			name:     "[Normal] pointer of big.Rat with precision and scale",
+			typeName: "*big.Rat",
+			opt:      sqltype.Option{Precision: 12, Scale: 2},
+			want:     "NUMERIC(12,2)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sqlite.ToSQL(tt.typeName, tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("SQLite.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLite_EnumCheck(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+		want   string
+	}{
+		{
+			name:   "[Normal] enum values",
+			values: []string{"active", "inactive"},
+			want:   "CHECK (`status` IN ('active', 'inactive'))",
+		},
+		{
+			name:   "[Normal] enum value has single quote",
+			values: []string{"it's"},
+			want:   "CHECK (`status` IN ('it''s'))",
+		},
+		{
+			name:   "[Normal] enum value has backslash",
+			values: []string{`C:\dir`},
+			want:   "CHECK (`status` IN ('C:\\dir'))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlite := SQLite{}
+			if got := sqlite.EnumCheck("status", tt.values); got != tt.want {
+				t.Errorf("SQLite.EnumCheck() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLite_LineComment(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+		want    string
+	}{
+		{name: "[Normal] comment", comment: "It's the title", want: "-- It's the title"},
+		{name: "[Normal] line breaks are folded", comment: "first line\nsecond line", want: "-- first line second line"},
+		{name: "[Normal] spaces are squeezed", comment: "  Journal   ID  ", want: "-- Journal ID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sqlite := SQLite{}
+			if got := sqlite.LineComment(tt.comment); got != tt.want {
+				t.Errorf("SQLite.LineComment() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSQLite_BoolLiteral(t *testing.T) {
+	sqlite := SQLite{}
+	if got := sqlite.BoolLiteral(true); got != "1" {
+		t.Errorf("SQLite.BoolLiteral(true) = %v, want 1", got)
+	}
+	if got := sqlite.BoolLiteral(false); got != "0" {
+		t.Errorf("SQLite.BoolLiteral(false) = %v, want 0", got)
+	}
+}
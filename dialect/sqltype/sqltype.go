@@ -0,0 +1,45 @@
+// Package sqltype provides the options of SQL type specified by the struct tag
+// (e.g. size, precision and scale). ddlmaker passes them to the dialects.
+package sqltype
+
+import "fmt"
+
+// Option is the options of SQL type. The zero value means that the option is not specified.
+type Option struct {
+	// Size is data size (e.g. VARCHAR(<size>)) or fractional seconds precision of time.
+	Size uint64
+	// Precision is the total number of digits of DECIMAL/NUMERIC.
+	Precision uint64
+	// Scale is the number of digits to the right of the decimal point. It is used with Precision.
+	Scale uint64
+	// Enum is the allowed values of the enum column.
+	Enum []string
+	// EnumName is the name of the enum type for the databases that define it
+	// separately from the table (e.g. CREATE TYPE in PostgreSQL).
+	EnumName string
+}
+
+// PrecisionScale return "(<precision>,<scale>)". If precision is not specified, return defaultValue.
+func (o Option) PrecisionScale(defaultValue string) string {
+	if o.Precision == 0 {
+		return defaultValue
+	}
+	return fmt.Sprintf("(%d,%d)", o.Precision, o.Scale)
+}
+
+// IsEnum return whether the column is enum.
+func (o Option) IsEnum() bool {
+	return len(o.Enum) > 0
+}
+
+// Storage is the storage kind of the generated column.
+type Storage string
+
+const (
+	// StorageDefault means the storage kind is not specified. The dialect uses the default of the database.
+	StorageDefault Storage = ""
+	// Virtual means the value is computed when it is read.
+	Virtual Storage = "VIRTUAL"
+	// Stored means the value is computed when the row is written, and it is stored.
+	Stored Storage = "STORED"
+)
@@ -0,0 +1,47 @@
+package sqltype
+
+import "testing"
+
+func TestOption_PrecisionScale(t *testing.T) {
+	tests := []struct {
+		name         string
+		opt          Option
+		defaultValue string
+		want         string
+	}{
+		{
+			name:         "[Normal] precision and scale",
+			opt:          Option{Precision: 12, Scale: 2},
+			defaultValue: "(65,30)",
+			want:         "(12,2)",
+		},
+		{
+			name:         "[Normal] precision without scale",
+			opt:          Option{Precision: 10},
+			defaultValue: "(65,30)",
+			want:         "(10,0)",
+		},
+		{
+			name:         "[Normal] precision is not specified",
+			opt:          Option{Size: 10},
+			defaultValue: "(65,30)",
+			want:         "(65,30)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.opt.PrecisionScale(tt.defaultValue); got != tt.want {
+				t.Errorf("Option.PrecisionScale() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOption_IsEnum(t *testing.T) {
+	if (Option{}).IsEnum() {
+		t.Error("Option{}.IsEnum() = true, want false")
+	}
+	if !(Option{Enum: []string{"active"}}).IsEnum() {
+		t.Error("Option{Enum: []string{\"active\"}}.IsEnum() = false, want true")
+	}
+}
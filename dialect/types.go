@@ -0,0 +1,47 @@
+package dialect
+
+import (
+	"reflect"
+	"sync"
+)
+
+var (
+	typesMu sync.RWMutex
+	// types is the SQL types of the Go types for each driver (driver name -> type name -> SQL type)
+	types = make(map[string]map[string]string)
+)
+
+// RegisterType maps the Go type to the SQL type of the driver. The registered SQL type
+// is used as is instead of Dialect.ToSQL(). If the type is already registered, it is overwritten.
+func RegisterType(driver string, rt reflect.Type, sqlType string) {
+	RegisterTypeName(driver, TypeName(rt), sqlType)
+}
+
+// RegisterTypeName is RegisterType for the type that can not be imported (e.g. third party type).
+// typeName is "<package path>.<type name>" (e.g. "github.com/google/uuid.UUID").
+func RegisterTypeName(driver, typeName, sqlType string) {
+	typesMu.Lock()
+	defer typesMu.Unlock()
+
+	if types[driver] == nil {
+		types[driver] = make(map[string]string)
+	}
+	types[driver][typeName] = sqlType
+}
+
+// LookupType return the SQL type of the Go type registered for the driver.
+func LookupType(driver string, rt reflect.Type) (string, bool) {
+	typesMu.RLock()
+	defer typesMu.RUnlock()
+
+	sqlType, ok := types[driver][TypeName(rt)]
+	return sqlType, ok
+}
+
+// TypeName return "<package path>.<type name>" of rt. If rt is not defined type, return rt.String().
+func TypeName(rt reflect.Type) string {
+	if rt.PkgPath() == "" || rt.Name() == "" {
+		return rt.String()
+	}
+	return rt.PkgPath() + "." + rt.Name()
+}
@@ -0,0 +1,77 @@
+package ddlmaker
+
+import "github.com/nao1215/nameconv"
+
+// NamingStrategy converts the struct name and the field name to the table name
+// and the column name. The name returned by Table() is also converted, but the column
+// name specified by "name" tag is used as is.
+type NamingStrategy interface {
+	TableName(name string) string
+	ColumnName(name string) string
+}
+
+// SnakeCase is NamingStrategy that converts name to snake_case. It's default.
+type SnakeCase struct{}
+
+// TableName return snake_case table name
+func (SnakeCase) TableName(name string) string {
+	return nameconv.ToSnakeCase(name)
+}
+
+// ColumnName return snake_case column name
+func (SnakeCase) ColumnName(name string) string {
+	return nameconv.ToSnakeCase(name)
+}
+
+// CamelCase is NamingStrategy that converts name to camelCase.
+type CamelCase struct{}
+
+// TableName return camelCase table name
+func (CamelCase) TableName(name string) string {
+	return nameconv.ToCamelCase(name)
+}
+
+// ColumnName return camelCase column name
+func (CamelCase) ColumnName(name string) string {
+	return nameconv.ToCamelCase(name)
+}
+
+// Verbatim is NamingStrategy that uses name as is.
+type Verbatim struct{}
+
+// TableName return name as is
+func (Verbatim) TableName(name string) string {
+	return name
+}
+
+// ColumnName return name as is
+func (Verbatim) ColumnName(name string) string {
+	return name
+}
+
+// PrefixSuffix is NamingStrategy that adds prefix and suffix to the name
+// converted by Strategy. If Strategy is nil, SnakeCase is used.
+type PrefixSuffix struct {
+	Strategy     NamingStrategy
+	TablePrefix  string
+	TableSuffix  string
+	ColumnPrefix string
+	ColumnSuffix string
+}
+
+// TableName return table name with prefix and suffix
+func (ps PrefixSuffix) TableName(name string) string {
+	return ps.TablePrefix + ps.strategy().TableName(name) + ps.TableSuffix
+}
+
+// ColumnName return column name with prefix and suffix
+func (ps PrefixSuffix) ColumnName(name string) string {
+	return ps.ColumnPrefix + ps.strategy().ColumnName(name) + ps.ColumnSuffix
+}
+
+func (ps PrefixSuffix) strategy() NamingStrategy {
+	if ps.Strategy == nil {
+		return SnakeCase{}
+	}
+	return ps.Strategy
+}
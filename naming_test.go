@@ -0,0 +1,53 @@
+package ddlmaker
+
+import "testing"
+
+func TestNamingStrategy(t *testing.T) {
+	tests := []struct {
+		name       string
+		ns         NamingStrategy
+		wantTable  string
+		wantColumn string
+	}{
+		{
+			name:       "[Normal] snake_case",
+			ns:         SnakeCase{},
+			wantTable:  "user_profile",
+			wantColumn: "user_id",
+		},
+		{
+			name:       "[Normal] camelCase",
+			ns:         CamelCase{},
+			wantTable:  "userProfile",
+			wantColumn: "userId",
+		},
+		{
+			name:       "[Normal] verbatim",
+			ns:         Verbatim{},
+			wantTable:  "UserProfile",
+			wantColumn: "UserID",
+		},
+		{
+			name:       "[Normal] prefix and suffix with default strategy",
+			ns:         PrefixSuffix{TablePrefix: "tbl_", TableSuffix: "s", ColumnPrefix: "col_"},
+			wantTable:  "tbl_user_profiles",
+			wantColumn: "col_user_id",
+		},
+		{
+			name:       "[Normal] prefix and suffix with verbatim",
+			ns:         PrefixSuffix{Strategy: Verbatim{}, TablePrefix: "tbl_", ColumnSuffix: "_"},
+			wantTable:  "tbl_UserProfile",
+			wantColumn: "UserID_",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.ns.TableName("UserProfile"); got != tt.wantTable {
+				t.Errorf("TableName() = %v, want %v", got, tt.wantTable)
+			}
+			if got := tt.ns.ColumnName("UserID"); got != tt.wantColumn {
+				t.Errorf("ColumnName() = %v, want %v", got, tt.wantColumn)
+			}
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package ddlmaker
 
 import (
+	"database/sql"
+	"database/sql/driver"
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/nao1215/ddl-maker/dialect"
-	"github.com/nao1215/nameconv"
+	"github.com/nao1215/ddl-maker/schema"
 )
 
 // Table is for type assertion
@@ -29,38 +32,675 @@ type Index interface {
 	Indexes() dialect.Indexes
 }
 
+// Check is for type assertion
+type Check interface {
+	Checks() []dialect.Check
+}
+
+// TableComment is for type assertion
+type TableComment interface {
+	TableComment() string
+}
+
+// Enum is for type assertion. The type of the field implements it to declare
+// the allowed values of the enum column.
+type Enum interface {
+	EnumValues() []string
+}
+
+// identifierValidator is for type assertion. A dialect implements it when
+// the database has restrictions on identifiers (e.g. length).
+type identifierValidator interface {
+	ValidateIdentifier(name string) error
+}
+
 func (dm *DDLMaker) parse() error {
+	var tagErrs TagErrors
+	// structError return err. In strict mode, err is recorded to report all problems
+	// of all structs at once, and nil is returned.
+	structError := func(err error) error {
+		if dm.config.Strict {
+			tagErrs = append(tagErrs, err)
+			return nil
+		}
+		return err
+	}
+
 	for _, s := range dm.Structs {
 		val := reflect.Indirect(reflect.ValueOf(s))
 		rt := val.Type()
 
-		var columns []dialect.Column
-		for i := 0; i < rt.NumField(); i++ {
-			rtField := rt.Field(i)
-			column, err := parseField(rtField, dm.Dialect)
+		p := dm.newStructParser(tableName(s, dm.namingStrategy()))
+		columns, err := p.parseFields(rt, embedding{})
+		tagErrs = append(tagErrs, p.errs...)
+		dm.IgnoredTagKeys = append(dm.IgnoredTagKeys, p.ignoredTagKeys...)
+		dm.Warnings = append(dm.Warnings, p.warnings...)
+		if err != nil {
+			if err := structError(fmt.Errorf("error parse %s: %w", rt.Name(), err)); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := validateColumnNames(columns); err != nil {
+			if err := structError(fmt.Errorf("error parse %s: %w", rt.Name(), err)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		tbl, err := parseTable(s, columns, p.constraints, dm.Dialect, dm.namingStrategy())
+		if err != nil {
+			if err := structError(fmt.Errorf("error parse %s: %w", rt.Name(), err)); err != nil {
+				return err
+			}
+			continue
+		}
+		if v, ok := dm.Dialect.(identifierValidator); ok {
+			if err := validateIdentifiers(tbl, v); err != nil {
+				if err := structError(fmt.Errorf("error validate identifier: %w", err)); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		dm.Tables = append(dm.Tables, tbl)
+	}
+
+	if len(tagErrs) > 0 {
+		return tagErrs
+	}
+	return nil
+}
+
+// structParser converts the fields of the struct to columns.
+type structParser struct {
+	// table is the table name of the struct.
+	table   string
+	dialect dialect.Dialect
+	naming  NamingStrategy
+	// compatibleTags is the struct tags (e.g. gorm) read when "ddl" tag key is not present.
+	compatibleTags []string
+	// visited is the structs that are being parsed. It detects recursive embedding.
+	visited map[reflect.Type]bool
+	// constraints is the primary key and indexes declared by the struct tags.
+	constraints tagConstraints
+	// ignoredTagKeys is the keys of the compatible tags that are not mapped to "ddl" tag.
+	ignoredTagKeys []IgnoredTagKey
+	// inferNullability makes the nullable type (pointer and sql.Null*) column NULL.
+	inferNullability bool
+	// warnings is the lint warnings of the struct fields.
+	warnings []string
+	// lookupType return the registered SQL type of the Go type.
+	lookupType func(rt reflect.Type) (string, bool)
+	// strict makes the problems of "ddl" tag errors. They are recorded in errs.
+	strict bool
+	errs   []error
+}
+
+// newStructParser return structParser initialized by the settings of DDLMaker.
+func (dm *DDLMaker) newStructParser(table string) *structParser {
+	return &structParser{
+		table:            table,
+		dialect:          dm.Dialect,
+		naming:           dm.namingStrategy(),
+		compatibleTags:   dm.config.CompatibleTags,
+		visited:          map[reflect.Type]bool{},
+		inferNullability: dm.config.InferNullability,
+		lookupType:       dm.lookupType,
+		strict:           dm.config.Strict,
+	}
+}
+
+// parseFields converts the fields of the struct to columns in declaration order.
+// The fields of the embedded structs (and the named nested structs that have
+// "embedded" tag) are promoted as columns. The column names of them are
+// decided by e.
+func (p *structParser) parseFields(rt reflect.Type, e embedding) ([]dialect.Column, error) {
+	p.visited[rt] = true
+	defer delete(p.visited, rt)
+
+	var columns []dialect.Column
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		tag, fc, err := p.fieldTag(rt, field)
+		if err != nil {
+			if err := p.fieldError(rt, field, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		specs := column{tag: tag}.specs()
+
+		if nested, ok := embeddedStruct(field, specs); ok {
+			if p.visited[nested] {
+				err := fmt.Errorf("%w: %s", ErrRecursiveEmbedding, nested.Name())
+				if err := p.fieldError(rt, field, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			cols, err := p.parseFields(nested, e.embed(field, specs, p.naming))
 			if err != nil {
-				if err == ErrIgnoreField {
+				return nil, err
+			}
+			columns = append(columns, cols...)
+			continue
+		}
+
+		if p.inferNullability {
+			tag = inferNull(field, tag, specs)
+		}
+		// The column of the generic wrapper (e.g. sql.Null[T]) is the column of T.
+		typeField := field
+		typeField.Type = unwrapGeneric(field.Type)
+		values := enumValues(typeField.Type, specs)
+		if len(values) > 0 {
+			// The enum column is the string column whose values are restricted.
+			enumType, err := enumBaseType(typeField.Type)
+			if err != nil {
+				if err := p.fieldError(rt, field, err); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			typeField.Type = enumType
+		}
+		column, err := parseField(typeField, e.columnName(field, specs, p.naming), tag, p.dialect)
+		if err != nil {
+			if err == ErrIgnoreField {
+				continue
+			}
+			if err := p.fieldError(rt, field, fmt.Errorf("error parse field: %w", err)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, ok := specs["type"]; !ok && len(values) == 0 {
+			column.sqlType, _ = p.lookupType(typeField.Type)
+		}
+		column.valuerTypeName = valuerTypeName(typeField.Type)
+		column.table = p.table
+		column.enumValues = values
+		column.literalKind = literalKindOf(typeField.Type)
+		if _, err := column.typeOption(); err != nil {
+			if err := p.fieldError(rt, field, fmt.Errorf("invalid value: %w", err)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if _, err := column.defaultValue(); err != nil {
+			if err := p.fieldError(rt, field, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		p.lint(rt, field)
+		columns = append(columns, column)
+		p.constraints.add(column.Name(), fc)
+	}
+	return columns, nil
+}
+
+var (
+	valuerType     = reflect.TypeOf((*driver.Valuer)(nil)).Elem()
+	enumType       = reflect.TypeOf((*Enum)(nil)).Elem()
+	stringType     = reflect.TypeOf("")
+	nullStringType = reflect.TypeOf(sql.NullString{})
+)
+
+// oppositeKeys is the pairs of "ddl" tag keys that can not be specified together.
+var oppositeKeys = map[string]string{
+	"null":    "notnull",
+	"notnull": "null",
+}
+
+// fieldTag return "ddl" tag of the field. The keys of the compatible tags are
+// added if the same keys are not present in "ddl" tag. If "ddl" tag is malformed,
+// return error. The unknown keys are warned.
+func (p *structParser) fieldTag(rt reflect.Type, field reflect.StructField) (string, fieldConstraint, error) {
+	tag := ddlTag(field)
+	tagSpecs, err := parseTag(tag)
+	if err != nil {
+		return "", fieldConstraint{}, err
+	}
+	p.checkTagKeys(rt, field, tagSpecs)
+	specs := make(map[string]string, len(tagSpecs))
+	for _, spec := range tagSpecs {
+		specs[spec.key] = spec.value
+	}
+	fc, err := tagConstraint(specs)
+	if err != nil {
+		return "", fieldConstraint{}, err
+	}
+	if _, _, err := generatedColumn(specs); err != nil {
+		return "", fieldConstraint{}, err
+	}
+
+	var elems []string
+	if tag != "" {
+		elems = []string{tag}
+	}
+
+	for _, tagName := range p.compatibleTags {
+		value, ok := field.Tag.Lookup(tagName)
+		if !ok {
+			continue
+		}
+
+		ct := convertTag(tagName, value)
+		for _, spec := range ct.specs {
+			key := strings.SplitN(spec, "=", 2)[0]
+			if _, ok := specs[key]; ok {
+				continue
+			}
+			if opposite, ok := oppositeKeys[key]; ok {
+				if _, ok := specs[opposite]; ok {
 					continue
 				}
-				return fmt.Errorf("error parse field: %w", err) // This pass will not go through.
 			}
-			columns = append(columns, column)
+			// The primary key and the auto increment column declared by "ddl" tag are not NULL.
+			if _, auto := specs["auto"]; key == "null" && (fc.primaryKey || auto) {
+				continue
+			}
+			specs[key] = ""
+			elems = append(elems, spec)
+		}
+		fc = fc.merge(ct.constraint)
+		for _, key := range ct.ignored {
+			p.ignoredTagKeys = append(p.ignoredTagKeys, IgnoredTagKey{
+				Struct: rt.Name(),
+				Field:  field.Name,
+				Tag:    tagName,
+				Key:    key,
+			})
+		}
+		for _, elem := range ct.invalid {
+			if p.strict {
+				p.fieldError(rt, field, fmt.Errorf("%w: %s tag %q can not be converted", ErrInvalidTag, tagName, elem))
+				continue
+			}
+			p.ignoredTagKeys = append(p.ignoredTagKeys, IgnoredTagKey{
+				Struct: rt.Name(),
+				Field:  field.Name,
+				Tag:    tagName,
+				Key:    elem,
+			})
+		}
+	}
+	return strings.Join(elems, ","), fc, nil
+}
+
+// conflictingTagKeys is the pairs of "ddl" tag keys that are rejected together in strict mode.
+var conflictingTagKeys = [][2]string{
+	{"null", "notnull"},
+	{"null", "auto"},
+	{"generated", "default"},
+	{"generated", "default_expr"},
+	{"generated", "auto"},
+}
+
+// checkTagKeys checks the keys of "ddl" tag. In strict mode, the unknown, duplicate
+// and conflicting keys are the errors. Otherwise, the unknown keys are warned.
+func (p *structParser) checkTagKeys(rt reflect.Type, field reflect.StructField, tagSpecs []tagSpec) {
+	seen := make(map[string]bool, len(tagSpecs))
+	for _, spec := range tagSpecs {
+		switch {
+		case !p.knownTagKey(spec.key) && !p.strict:
+			p.warnings = append(p.warnings,
+				fmt.Sprintf("%s.%s: unknown ddl tag key %q", rt.Name(), field.Name, spec.key))
+		case !p.knownTagKey(spec.key):
+			p.fieldError(rt, field, fmt.Errorf("%w: %q", ErrUnknownTagKey, spec.key))
+		case seen[spec.key] && p.strict:
+			p.fieldError(rt, field, fmt.Errorf("%w: %q", ErrDuplicateTagKey, spec.key))
 		}
+		seen[spec.key] = true
+	}
 
-		table := parseTable(s, columns, dm.Dialect)
-		dm.Tables = append(dm.Tables, table)
+	if !p.strict {
+		return
+	}
+	for _, keys := range conflictingTagKeys {
+		if seen[keys[0]] && seen[keys[1]] {
+			p.fieldError(rt, field, fmt.Errorf("%w: %q and %q", ErrConflictingTagKeys, keys[0], keys[1]))
+		}
 	}
-	return nil
 }
 
-func parseField(field reflect.StructField, d dialect.Dialect) (dialect.Column, error) {
-	tagStr := strings.Replace(field.Tag.Get(TAGPREFIX), " ", "", -1)
+// fieldError return the error of the field that has the struct and field name. In strict
+// mode, the error is recorded to report all problems at once, and nil is returned.
+func (p *structParser) fieldError(rt reflect.Type, field reflect.StructField, err error) error {
+	err = fmt.Errorf("%s.%s: %w", rt.Name(), field.Name, err)
+	if p.strict {
+		p.errs = append(p.errs, err)
+		return nil
+	}
+	return err
+}
 
-	for _, tag := range strings.Split(tagStr, ",") {
-		if tag == IGNORETAG {
-			return nil, ErrIgnoreField
+// knownTagKey return whether ddlmaker or the dialect understands the key of "ddl" tag.
+func (p *structParser) knownTagKey(key string) bool {
+	if knownTagKeys[key] {
+		return true
+	}
+	if v, ok := p.dialect.(tagKeyProvider); ok {
+		for _, k := range v.TagKeys() {
+			if k == key {
+				return true
+			}
 		}
 	}
+	return false
+}
+
+// lint warns the suspicious struct tag of the field.
+func (p *structParser) lint(rt reflect.Type, field reflect.StructField) {
+	// The nil slice (e.g. []byte) is stored as NULL, so it may be tagged "null".
+	nullable := nullableType(field.Type) || field.Type.Kind() == reflect.Slice
+	specs := column{tag: ddlTag(field)}.specs()
+	if _, ok := specs["null"]; ok && !nullable {
+		p.warnings = append(p.warnings,
+			fmt.Sprintf("%s.%s: %s is not nullable type, but tagged \"null\"", rt.Name(), field.Name, field.Type))
+	}
+	// The strict mode rejects them as the conflicting keys.
+	if _, ok := specs["generated"]; ok && !p.strict {
+		for _, key := range []string{"default", "default_expr", "auto"} {
+			if _, ok := specs[key]; ok {
+				p.warnings = append(p.warnings,
+					fmt.Sprintf("%s.%s: \"%s\" is ignored for the generated column", rt.Name(), field.Name, key))
+			}
+		}
+	}
+}
+
+// inferNull return tag that "null" key is added if the field is nullable type.
+// If "null" or "notnull" key is specified, return tag as is.
+func inferNull(field reflect.StructField, tag string, specs map[string]string) string {
+	_, null := specs["null"]
+	_, notNull := specs["notnull"]
+	if null || notNull || !nullableType(field.Type) {
+		return tag
+	}
+	if tag == "" {
+		return "null"
+	}
+	return tag + ",null"
+}
+
+// nullableType return whether rt can have NULL. It is pointer, the struct that has
+// "Valid bool" field (e.g. sql.NullString, mysql.NullTime) or the generic wrapper
+// (e.g. sql.Null[T], Optional[T]).
+func nullableType(rt reflect.Type) bool {
+	if rt.Kind() == reflect.Ptr {
+		return true
+	}
+	if _, ok := validWrapperElem(rt); ok {
+		return true
+	}
+	_, ok := genericElem(rt)
+	return ok
+}
+
+// validWrapperElem return the type of the value field of the struct that has
+// only the value field and "Valid bool" field (e.g. sql.NullString, sql.Null[T]).
+func validWrapperElem(rt reflect.Type) (reflect.Type, bool) {
+	if rt.Kind() != reflect.Struct || rt.NumField() != 2 {
+		return nil, false
+	}
+	for i := 0; i < rt.NumField(); i++ {
+		if f := rt.Field(i); f.Name == "Valid" && f.Type.Kind() == reflect.Bool {
+			return rt.Field(1 - i).Type, true
+		}
+	}
+	return nil, false
+}
+
+// genericElem return T of the generic wrapper type (e.g. sql.Null[T], Optional[T]).
+// The generic wrapper is the struct that has one type argument T, and has only
+// the field of T, or the field of T and "Valid bool" field.
+func genericElem(rt reflect.Type) (reflect.Type, bool) {
+	if rt.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	// The name of the instantiated type has the type argument (e.g. "Null[int64]").
+	name := rt.Name()
+	start := strings.Index(name, "[")
+	if start < 0 || !strings.HasSuffix(name, "]") {
+		return nil, false
+	}
+	typeArg := name[start+1 : len(name)-1]
+
+	elem, ok := validWrapperElem(rt)
+	if !ok {
+		if rt.NumField() != 1 {
+			return nil, false
+		}
+		elem = rt.Field(0).Type
+	}
+	if dialect.TypeName(elem) != typeArg {
+		return nil, false
+	}
+	return elem, true
+}
+
+// unwrapGeneric return T of the generic wrappers. The pointer to the generic wrapper
+// is the pointer to T. If rt is not the generic wrapper, return rt.
+func unwrapGeneric(rt reflect.Type) reflect.Type {
+	if rt.Kind() == reflect.Ptr {
+		if elem := unwrapGeneric(rt.Elem()); elem != rt.Elem() {
+			return reflect.PtrTo(elem)
+		}
+		return rt
+	}
+	for {
+		elem, ok := genericElem(rt)
+		if !ok {
+			return rt
+		}
+		rt = elem
+	}
+}
+
+// embeddedStruct return the struct type whose fields are promoted as columns.
+// It is the anonymous struct (or pointer to struct) field, or the named struct
+// field that has "embedded" tag.
+func embeddedStruct(field reflect.StructField, specs map[string]string) (reflect.Type, bool) {
+	if _, ok := specs[IGNORETAG]; ok {
+		return nil, false
+	}
+
+	rt := field.Type
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt.Kind() != reflect.Struct {
+		return nil, false
+	}
+
+	if _, ok := specs[EMBEDDEDTAG]; ok {
+		return rt, true
+	}
+	// time.Time, sql.NullString, sql.Null[T] and so on are mapped to one column even if embedded.
+	if !field.Anonymous || rt.PkgPath() == "time" || rt.PkgPath() == "database/sql" {
+		return nil, false
+	}
+	if _, ok := genericElem(rt); ok {
+		return nil, false
+	}
+	return rt, true
+}
+
+// embedding decides the column names of the fields in the embedded struct.
+// prefix is specified by "prefix" tag, and path is the concatenated names of
+// the named nested struct fields. They are empty for the outermost struct.
+type embedding struct {
+	prefix string
+	path   string
+}
+
+// embed return embedding for the fields of the embedded struct field.
+// The "prefix" tag takes precedence. The named nested struct is prefixed by its
+// field name by default, and the anonymous struct is not prefixed.
+func (e embedding) embed(field reflect.StructField, specs map[string]string, ns NamingStrategy) embedding {
+	if prefix, ok := specs[PREFIXTAG]; ok {
+		return embedding{prefix: e.pathPrefix(ns) + prefix}
+	}
+	if field.Anonymous {
+		return e
+	}
+	return embedding{prefix: e.prefix, path: e.path + field.Name}
+}
+
+// columnName return column name of the field. The "name" tag is used as is,
+// otherwise the field name is converted by ns.
+func (e embedding) columnName(field reflect.StructField, specs map[string]string, ns NamingStrategy) string {
+	if name := specs[NAMETAG]; name != "" {
+		return e.pathPrefix(ns) + name
+	}
+	return e.prefix + ns.ColumnName(e.path+field.Name)
+}
+
+// pathPrefix return prefix and path converted by ns.
+func (e embedding) pathPrefix(ns NamingStrategy) string {
+	if e.path == "" {
+		return e.prefix
+	}
+	return e.prefix + ns.ColumnName(e.path) + "_"
+}
+
+// valuerTypeName return the Go type name of the value that the zero value of rt returns
+// by driver.Valuer. It is used when the dialect does not know rt. If rt does not implement
+// driver.Valuer or the value is nil, return empty string.
+func valuerTypeName(rt reflect.Type) (typeName string) {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+
+	var v reflect.Value
+	switch {
+	case rt.Kind() == reflect.Interface:
+		return ""
+	case rt.Implements(valuerType):
+		v = reflect.Zero(rt)
+	case reflect.PtrTo(rt).Implements(valuerType):
+		v = reflect.New(rt)
+	default:
+		return ""
+	}
+
+	// Value() of the zero value may panic (e.g. nil map).
+	defer func() {
+		if recover() != nil {
+			typeName = ""
+		}
+	}()
+	value, err := v.Interface().(driver.Valuer).Value()
+	if err != nil || value == nil {
+		return ""
+	}
+	if _, ok := value.(time.Time); ok {
+		return "time.Time"
+	}
+	return reflect.TypeOf(value).String()
+}
+
+// enumValues return the allowed values of the enum column. They are specified by
+// "enum" tag (e.g. enum=active|inactive) or EnumValues() of the field type.
+// The "enum" tag takes precedence. If the column is not enum, return nil.
+func enumValues(rt reflect.Type, specs map[string]string) []string {
+	if values := specs[ENUMTAG]; values != "" {
+		enum := strings.Split(values, "|")
+		for i := range enum {
+			enum[i] = strings.TrimSpace(enum[i])
+		}
+		return enum
+	}
+
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	switch {
+	case rt.Kind() == reflect.Interface:
+		return nil
+	case rt.Implements(enumType):
+		return reflect.Zero(rt).Interface().(Enum).EnumValues()
+	case reflect.PtrTo(rt).Implements(enumType):
+		return reflect.New(rt).Interface().(Enum).EnumValues()
+	default:
+		return nil
+	}
+}
+
+// literalKindOf return the kind of the literal of "default" tag for the Go type.
+// sql.Null* (e.g. sql.NullString) is the kind of its value. The other types that are not
+// string, bool and number (e.g. time.Time, []byte) write the default value as is.
+func literalKindOf(rt reflect.Type) literalKind {
+	if rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt.Kind() == reflect.Struct && rt.PkgPath() == "database/sql" && strings.HasPrefix(rt.Name(), "Null") {
+		return literalKindOf(rt.Field(0).Type)
+	}
+
+	switch rt.Kind() {
+	case reflect.String:
+		return stringLiteral
+	case reflect.Bool:
+		return boolLiteral
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return numberLiteral
+	}
+	// decimal.Decimal (shopspring/decimal) and big.Rat
+	if rt.Name() == "Decimal" || (rt.PkgPath() == "math/big" && rt.Name() == "Rat") {
+		return numberLiteral
+	}
+	return rawLiteral
+}
+
+// enumBaseType return string (or *string for the nullable type) type for the enum column.
+// The enum column must be string type (e.g. string, type Status string, sql.NullString).
+func enumBaseType(rt reflect.Type) (reflect.Type, error) {
+	elem := rt
+	if elem.Kind() == reflect.Ptr {
+		elem = elem.Elem()
+	}
+
+	switch {
+	case elem == nullStringType:
+		return reflect.PtrTo(stringType), nil
+	case elem.Kind() != reflect.String:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidEnumType, rt)
+	case rt.Kind() == reflect.Ptr:
+		return reflect.PtrTo(stringType), nil
+	default:
+		return stringType, nil
+	}
+}
+
+// ddlTag return "ddl" tag of the struct field.
+func ddlTag(field reflect.StructField) string {
+	return field.Tag.Get(TAGPREFIX)
+}
+
+// validateColumnNames detects the column name collision. It occurs between
+// the fields of the embedded structs and the outer fields.
+func validateColumnNames(columns []dialect.Column) error {
+	names := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		if names[c.Name()] {
+			return fmt.Errorf("%w: %s", ErrDuplicateColumn, c.Name())
+		}
+		names[c.Name()] = true
+	}
+	return nil
+}
+
+func parseField(field reflect.StructField, name, tagStr string, d dialect.Dialect) (column, error) {
+	if _, ok := (column{tag: tagStr}).specs()[IGNORETAG]; ok {
+		return column{}, ErrIgnoreField
+	}
 
 	var typeName string
 	switch {
@@ -82,21 +722,24 @@ func parseField(field reflect.StructField, d dialect.Dialect) (dialect.Column, e
 		typeName = field.Type.Name()
 	}
 
-	return newColumn(nameconv.ToSnakeCase(field.Name), typeName, tagStr, d), nil
+	return newColumn(name, typeName, tagStr, d), nil
+}
+
+// tableName return table name of the struct. Table() takes precedence over the struct name.
+func tableName(s interface{}, ns NamingStrategy) string {
+	if v, ok := s.(Table); ok {
+		return ns.TableName(v.Table())
+	}
+	val := reflect.Indirect(reflect.ValueOf(s))
+	return ns.TableName(val.Type().Name())
 }
 
-func parseTable(s interface{}, columns []dialect.Column, d dialect.Dialect) dialect.Table {
-	var tableName string
+func parseTable(s interface{}, columns []dialect.Column, tc tagConstraints, d dialect.Dialect, ns NamingStrategy) (table, error) {
 	var primaryKey dialect.PrimaryKey
 	var foreignKeys dialect.ForeignKeys
 	var indexes dialect.Indexes
 
-	if v, ok := s.(Table); ok {
-		tableName = nameconv.ToSnakeCase(v.Table())
-	} else {
-		val := reflect.Indirect(reflect.ValueOf(s))
-		tableName = nameconv.ToSnakeCase(val.Type().Name())
-	}
+	tableName := tableName(s, ns)
 	if v, ok := s.(PrimaryKey); ok {
 		primaryKey = v.PrimaryKey()
 	}
@@ -107,5 +750,458 @@ func parseTable(s interface{}, columns []dialect.Column, d dialect.Dialect) dial
 		indexes = v.Indexes()
 	}
 
-	return newTable(tableName, primaryKey, foreignKeys, columns, indexes, d)
+	// The constraints declared by the struct tags are merged with the methods.
+	var err error
+	if primaryKey, err = mergePrimaryKey(primaryKey, tc.primaryKeyConstraint()); err != nil {
+		return table{}, err
+	}
+	if indexes, err = mergeIndexes(indexes, tc.indexConstraints(tableName), d); err != nil {
+		return table{}, err
+	}
+	if foreignKeys, err = mergeForeignKeys(foreignKeys, tc.foreignKeyConstraints(), d); err != nil {
+		return table{}, err
+	}
+
+	var checks []dialect.Check
+	if v, ok := s.(Check); ok {
+		checks = v.Checks()
+	}
+	if checks, err = mergeChecks(checks, tc.checkConstraints(tableName)); err != nil {
+		return table{}, err
+	}
+	if err := validateCheckColumns(checks, columns); err != nil {
+		return table{}, err
+	}
+
+	primaryKey, foreignKeys, indexes = bindConstraints(tableName, primaryKey, foreignKeys, indexes, d)
+
+	tbl := newTable(tableName, primaryKey, foreignKeys, columns, indexes, d)
+	tbl.checks = bindChecks(checks, d)
+	if v, ok := s.(TableComment); ok {
+		tbl.comment = v.TableComment()
+	}
+	return tbl, nil
+}
+
+// mergePrimaryKey return the primary key declared by the method or the struct tags.
+// If both are declared, they must have the same columns.
+func mergePrimaryKey(declared, tagged dialect.PrimaryKey) (dialect.PrimaryKey, error) {
+	switch {
+	case tagged == nil:
+		return declared, nil
+	case declared == nil:
+		return tagged, nil
+	case !reflect.DeepEqual(declared.Columns(), tagged.Columns()):
+		return nil, fmt.Errorf("%w: primary key %v by PrimaryKey() and %v by tags",
+			ErrConflictingConstraint, declared.Columns(), tagged.Columns())
+	default:
+		return declared, nil
+	}
+}
+
+// uniqueIndex is for type assertion. The index implements it when it knows the uniqueness.
+type uniqueIndex interface {
+	Unique() bool
+}
+
+// mergeIndexes return the indexes declared by the method and the struct tags. The index
+// declared by both must have the same columns (and uniqueness if known), and it is used once.
+// The indexes of the dialect packages return the quoted names, so they are compared unquoted.
+func mergeIndexes(declared, tagged dialect.Indexes, q dialect.Dialect) (dialect.Indexes, error) {
+	indexes := declared
+	for _, t := range tagged {
+		d := findIndex(declared, unquoteIdentifier(t.Name(), q), q)
+		if d == nil {
+			indexes = append(indexes, t)
+			continue
+		}
+
+		du, dok := d.(uniqueIndex)
+		tu, tok := t.(uniqueIndex)
+		dColumns, tColumns := unquoteIdentifiers(d.Columns(), q), unquoteIdentifiers(t.Columns(), q)
+		if !reflect.DeepEqual(dColumns, tColumns) || (dok && tok && du.Unique() != tu.Unique()) {
+			return nil, fmt.Errorf("%w: index %s on %v by Indexes() and %v by tags",
+				ErrConflictingConstraint, unquoteIdentifier(t.Name(), q), dColumns, tColumns)
+		}
+	}
+	return indexes, nil
+}
+
+func findIndex(indexes dialect.Indexes, name string, q dialect.Dialect) dialect.Index {
+	for _, index := range indexes {
+		if unquoteIdentifier(index.Name(), q) == name {
+			return index
+		}
+	}
+	return nil
+}
+
+// mergeForeignKeys return the foreign keys declared by the method and the struct tags.
+// The foreign key of the same columns declared by both must have the same reference
+// and referential actions, and it is used once. The identifiers are compared unquoted.
+func mergeForeignKeys(declared, tagged dialect.ForeignKeys, q dialect.Dialect) (dialect.ForeignKeys, error) {
+	foreignKeys := declared
+	for _, t := range tagged {
+		d := findForeignKey(declared, unquoteIdentifiers(t.ForeignColumns(), q), q)
+		if d == nil {
+			foreignKeys = append(foreignKeys, t)
+			continue
+		}
+
+		dTable, tTable := unquoteIdentifier(d.ReferenceTableName(), q), unquoteIdentifier(t.ReferenceTableName(), q)
+		dColumns, tColumns := unquoteIdentifiers(d.ReferenceColumns(), q), unquoteIdentifiers(t.ReferenceColumns(), q)
+		if dTable != tTable || !reflect.DeepEqual(dColumns, tColumns) ||
+			d.UpdateOption() != t.UpdateOption() || d.DeleteOption() != t.DeleteOption() {
+			return nil, fmt.Errorf("%w: foreign key %v references %s%v by ForeignKeys() and %s%v by tags",
+				ErrConflictingConstraint, unquoteIdentifiers(t.ForeignColumns(), q), dTable, dColumns, tTable, tColumns)
+		}
+	}
+	return foreignKeys, nil
+}
+
+func findForeignKey(foreignKeys dialect.ForeignKeys, columns []string, q dialect.Dialect) dialect.ForeignKey {
+	for _, foreignKey := range foreignKeys {
+		if reflect.DeepEqual(unquoteIdentifiers(foreignKey.ForeignColumns(), q), columns) {
+			return foreignKey
+		}
+	}
+	return nil
+}
+
+// unquoteIdentifier return the identifier without the quotes of the dialect (e.g. "users" to users).
+func unquoteIdentifier(s string, d dialect.Dialect) string {
+	q := d.Quote("")
+	if len(q) == 2 && len(s) >= 2 && s[0] == q[0] && s[len(s)-1] == q[1] {
+		return s[1 : len(s)-1]
+	}
+	return s
+}
+
+func unquoteIdentifiers(ss []string, d dialect.Dialect) []string {
+	unquoted := make([]string, 0, len(ss))
+	for _, s := range ss {
+		unquoted = append(unquoted, unquoteIdentifier(s, d))
+	}
+	return unquoted
+}
+
+// mergeChecks return the check constraints declared by the method and the struct tags.
+// The check constraint of the same name declared by both must have the same expression,
+// and it is used once.
+func mergeChecks(declared, tagged []dialect.Check) ([]dialect.Check, error) {
+	checks := declared
+	for _, t := range tagged {
+		d := findCheck(declared, t.Name())
+		if d == nil {
+			checks = append(checks, t)
+			continue
+		}
+
+		if d.Expression() != t.Expression() {
+			return nil, fmt.Errorf("%w: check %s is (%s) by Checks() and (%s) by tags",
+				ErrConflictingConstraint, t.Name(), d.Expression(), t.Expression())
+		}
+	}
+	return checks, nil
+}
+
+func findCheck(checks []dialect.Check, name string) dialect.Check {
+	for _, check := range checks {
+		if check.Name() == name {
+			return check
+		}
+	}
+	return nil
+}
+
+// validateCheckColumns detects the check constraint that references the column
+// that the table does not have (e.g. the column renamed by NamingStrategy).
+func validateCheckColumns(checks []dialect.Check, columns []dialect.Column) error {
+	names := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		names[c.Name()] = true
+	}
+
+	for _, check := range checks {
+		for _, column := range check.Columns() {
+			if !names[column] {
+				return fmt.Errorf("%w: check %s references %s", ErrUnknownColumn, check.Name(), column)
+			}
+		}
+	}
+	return nil
+}
+
+// bindChecks quotes the names of the dialect-agnostic check constraints by the dialect.
+func bindChecks(checks []dialect.Check, d dialect.Dialect) []dialect.Check {
+	var bound []dialect.Check
+	for _, check := range checks {
+		if c, ok := check.(schema.Check); ok {
+			check = c.Bind(d)
+		}
+		bound = append(bound, check)
+	}
+	return bound
+}
+
+// bindConstraints binds the dialect-agnostic constraints (schema package) to the dialect.
+// If the dialect does not implement schema.Renderer, they are rendered in standard SQL.
+func bindConstraints(tableName string, primaryKey dialect.PrimaryKey, foreignKeys dialect.ForeignKeys,
+	indexes dialect.Indexes, d dialect.Dialect) (dialect.PrimaryKey, dialect.ForeignKeys, dialect.Indexes) {
+	r, _ := d.(schema.Renderer)
+
+	if pk, ok := primaryKey.(schema.PrimaryKey); ok {
+		primaryKey = pk.Bind(r)
+	}
+
+	var boundForeignKeys dialect.ForeignKeys
+	for _, foreignKey := range foreignKeys {
+		if fk, ok := foreignKey.(schema.ForeignKey); ok {
+			foreignKey = fk.Bind(r)
+		}
+		boundForeignKeys = append(boundForeignKeys, foreignKey)
+	}
+
+	var boundIndexes dialect.Indexes
+	for _, index := range indexes {
+		if i, ok := index.(schema.Index); ok {
+			index = i.Bind(tableName, r)
+		}
+		boundIndexes = append(boundIndexes, index)
+	}
+
+	return primaryKey, boundForeignKeys, boundIndexes
+}
+
+// validateIdentifiers validates table name, column names and index names.
+func validateIdentifiers(t table, v identifierValidator) error {
+	names := []string{t.name}
+	for _, c := range t.columns {
+		names = append(names, c.Name())
+	}
+	for _, i := range t.indexes {
+		names = append(names, i.Name())
+	}
+	for _, c := range t.checks {
+		names = append(names, c.Name())
+	}
+
+	for _, name := range names {
+		if err := v.ValidateIdentifier(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fieldConstraint is the primary key, indexes, foreign key and check constraint declared
+// by the struct tag of a field.
+type fieldConstraint struct {
+	primaryKey bool
+	indexes    []tagIndex
+	foreignKey *tagForeignKey
+	// check is the expression of the check constraint. It is empty if not declared.
+	check string
+}
+
+// merge return fieldConstraint that has the constraints of fc and other.
+// The foreign key and check constraint of fc take precedence.
+func (fc fieldConstraint) merge(other fieldConstraint) fieldConstraint {
+	foreignKey := fc.foreignKey
+	if foreignKey == nil {
+		foreignKey = other.foreignKey
+	}
+	check := fc.check
+	if check == "" {
+		check = other.check
+	}
+	return fieldConstraint{
+		primaryKey: fc.primaryKey || other.primaryKey,
+		indexes:    append(fc.indexes, other.indexes...),
+		foreignKey: foreignKey,
+		check:      check,
+	}
+}
+
+// tagConstraint return the constraints declared by "pk", "index", "unique", "fk" and "check" tags.
+// The value of "index" and "unique" is the index name. "fk" is "<table>.<column>",
+// and "onupdate" and "ondelete" are the referential actions of it. "check" is the expression.
+func tagConstraint(specs map[string]string) (fieldConstraint, error) {
+	var fc fieldConstraint
+	_, fc.primaryKey = specs["pk"]
+	if check, ok := specs["check"]; ok {
+		if check == "" {
+			return fc, fmt.Errorf("%w: \"check\" requires the expression", ErrInvalidTag)
+		}
+		fc.check = check
+	}
+	if name, ok := specs["index"]; ok {
+		fc.indexes = append(fc.indexes, tagIndex{name: name})
+	}
+	if name, ok := specs["unique"]; ok {
+		fc.indexes = append(fc.indexes, tagIndex{name: name, unique: true})
+	}
+
+	reference, ok := specs["fk"]
+	if !ok {
+		for _, key := range []string{"onupdate", "ondelete"} {
+			if _, ok := specs[key]; ok {
+				return fc, fmt.Errorf("%w: %q requires \"fk\"", ErrInvalidTag, key)
+			}
+		}
+		return fc, nil
+	}
+
+	i := strings.LastIndex(reference, ".")
+	if i <= 0 || i == len(reference)-1 {
+		return fc, fmt.Errorf("%w: fk must be <table>.<column>: %q", ErrInvalidTag, reference)
+	}
+	fk := tagForeignKey{referenceTable: reference[:i], referenceColumn: reference[i+1:]}
+	var err error
+	if action, ok := specs["onupdate"]; ok {
+		if fk.onUpdate, err = schema.ParseAction(action); err != nil {
+			return fc, fmt.Errorf("onupdate: %w", err)
+		}
+	}
+	if action, ok := specs["ondelete"]; ok {
+		if fk.onDelete, err = schema.ParseAction(action); err != nil {
+			return fc, fmt.Errorf("ondelete: %w", err)
+		}
+	}
+	fc.foreignKey = &fk
+	return fc, nil
+}
+
+// tagForeignKey is the foreign key declared by "fk" tag.
+type tagForeignKey struct {
+	column          string
+	referenceTable  string
+	referenceColumn string
+	onUpdate        schema.Action
+	onDelete        schema.Action
+}
+
+// tagIndex is the index declared by the struct tags. The fields that have the same
+// index name compose the composite index. If name is empty, the index has one column.
+type tagIndex struct {
+	name    string
+	unique  bool
+	columns []string
+}
+
+// tagCheck is the check constraint declared by "check" tag.
+type tagCheck struct {
+	column     string
+	expression string
+}
+
+// tagConstraints is the primary key, indexes, foreign keys and check constraints declared by the struct tags.
+type tagConstraints struct {
+	primaryKey  []string
+	indexes     []tagIndex
+	foreignKeys []tagForeignKey
+	checks      []tagCheck
+}
+
+// add adds the constraints of the column in declaration order.
+func (tc *tagConstraints) add(column string, fc fieldConstraint) {
+	if fc.primaryKey {
+		tc.primaryKey = append(tc.primaryKey, column)
+	}
+
+	for _, index := range fc.indexes {
+		if index.name != "" {
+			if i := tc.indexOf(index.name); i >= 0 {
+				// The same index may be declared by "ddl" tag and the compatible tag.
+				if !containsString(tc.indexes[i].columns, column) {
+					tc.indexes[i].columns = append(tc.indexes[i].columns, column)
+				}
+				tc.indexes[i].unique = tc.indexes[i].unique || index.unique
+				continue
+			}
+		}
+		tc.indexes = append(tc.indexes, tagIndex{name: index.name, unique: index.unique, columns: []string{column}})
+	}
+
+	if fc.foreignKey != nil {
+		fk := *fc.foreignKey
+		fk.column = column
+		tc.foreignKeys = append(tc.foreignKeys, fk)
+	}
+
+	if fc.check != "" {
+		tc.checks = append(tc.checks, tagCheck{column: column, expression: fc.check})
+	}
+}
+
+func containsString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (tc tagConstraints) indexOf(name string) int {
+	for i, index := range tc.indexes {
+		if index.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// primaryKeyConstraint return dialect-agnostic primary key. If the primary key is not declared, return nil.
+func (tc tagConstraints) primaryKeyConstraint() dialect.PrimaryKey {
+	if len(tc.primaryKey) == 0 {
+		return nil
+	}
+	return schema.AddPrimaryKey(tc.primaryKey...)
+}
+
+// indexConstraints return dialect-agnostic indexes. The index without name is
+// named "idx_<table>_<column>".
+func (tc tagConstraints) indexConstraints(tableName string) dialect.Indexes {
+	var indexes dialect.Indexes
+	for _, index := range tc.indexes {
+		name := index.name
+		if name == "" {
+			name = fmt.Sprintf("idx_%s_%s", tableName, strings.Join(index.columns, "_"))
+		}
+		if index.unique {
+			indexes = append(indexes, schema.AddUniqueIndex(name, index.columns...))
+		} else {
+			indexes = append(indexes, schema.AddIndex(name, index.columns...))
+		}
+	}
+	return indexes
+}
+
+// foreignKeyConstraints return dialect-agnostic foreign keys.
+func (tc tagConstraints) foreignKeyConstraints() dialect.ForeignKeys {
+	var foreignKeys dialect.ForeignKeys
+	for _, fk := range tc.foreignKeys {
+		var options []schema.ForeignKeyOption
+		if fk.onUpdate != "" {
+			options = append(options, schema.OnUpdate(fk.onUpdate))
+		}
+		if fk.onDelete != "" {
+			options = append(options, schema.OnDelete(fk.onDelete))
+		}
+		foreignKeys = append(foreignKeys,
+			schema.AddForeignKey([]string{fk.column}, []string{fk.referenceColumn}, fk.referenceTable, options...))
+	}
+	return foreignKeys
+}
+
+// checkConstraints return dialect-agnostic check constraints named "chk_<table>_<column>".
+// They reference the column that has "check" tag.
+func (tc tagConstraints) checkConstraints(tableName string) []dialect.Check {
+	var checks []dialect.Check
+	for _, check := range tc.checks {
+		name := fmt.Sprintf("chk_%s_%s", tableName, check.column)
+		checks = append(checks, schema.AddCheck(name, check.expression, check.column))
+	}
+	return checks
 }
@@ -0,0 +1,78 @@
+//go:build go1.22
+
+package ddlmaker
+
+import (
+	"database/sql"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type Coupon struct {
+	ID        uint64
+	Code      sql.Null[string] `ddl:"size=16"`
+	Discount  sql.Null[float64]
+	ExpiredAt sql.Null[time.Time]
+	Limit     sql.Null[int64] `ddl:"null"`
+}
+
+func TestDDLMaker_parseSQLNull(t *testing.T) {
+	tests := []struct {
+		name             string
+		inferNullability bool
+		want             []string
+	}{
+		{
+			name:             "[Normal] sql.Null[T] is the column of T",
+			inferNullability: false,
+			want: []string{
+				"`id` BIGINT unsigned NOT NULL",
+				"`code` VARCHAR(16) NOT NULL",
+				"`discount` DOUBLE NOT NULL",
+				"`expired_at` DATETIME NOT NULL",
+				"`limit` BIGINT NULL",
+			},
+		},
+		{
+			name:             "[Normal] sql.Null[T] is NULL with nullability inference",
+			inferNullability: true,
+			want: []string{
+				"`id` BIGINT unsigned NOT NULL",
+				"`code` VARCHAR(16) NULL",
+				"`discount` DOUBLE NULL",
+				"`expired_at` DATETIME NULL",
+				"`limit` BIGINT NULL",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, InferNullability: tt.inferNullability})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.AddStruct(Coupon{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, c := range dm.Tables[0].Columns() {
+				sql, err := c.ToSQL()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, sql)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+			if len(dm.Warnings) != 0 {
+				t.Errorf("warnings = %v", dm.Warnings)
+			}
+		})
+	}
+}
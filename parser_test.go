@@ -2,13 +2,25 @@ package ddlmaker
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/netip"
 	"reflect"
 	"testing"
 	"time"
 
 	"github.com/nao1215/ddl-maker/dialect"
+	"github.com/nao1215/ddl-maker/dialect/cockroach"
+	"github.com/nao1215/ddl-maker/dialect/duckdb"
 	"github.com/nao1215/ddl-maker/dialect/mock"
+	"github.com/nao1215/ddl-maker/dialect/mssql"
 	"github.com/nao1215/ddl-maker/dialect/mysql"
+	"github.com/nao1215/ddl-maker/dialect/postgres"
+	"github.com/nao1215/ddl-maker/dialect/sqlite"
+	"github.com/nao1215/ddl-maker/schema"
+	"github.com/nao1215/nameconv"
 )
 
 type T1 struct {
@@ -86,7 +98,7 @@ func TestParseField(t *testing.T) {
 	}
 
 	for i := 0; i < rt.NumField(); i++ {
-		column, err := parseField(rt.Field(i), mysql.MySQL{})
+		column, err := parseField(rt.Field(i), nameconv.ToSnakeCase(rt.Field(i).Name), ddlTag(rt.Field(i)), mysql.MySQL{})
 		if err != nil {
 			if err == ErrIgnoreField {
 				continue
@@ -105,7 +117,10 @@ func TestParseTable(t *testing.T) {
 	d := mysql.MySQL{}
 
 	var columns []dialect.Column
-	table := parseTable(t1, columns, d)
+	table, err := parseTable(t1, columns, tagConstraints{}, d, SnakeCase{})
+	if err != nil {
+		t.Fatal(err)
+	}
 	if table.Name() != d.Quote(t1.Table()) {
 		t.Fatal("error parse table name", table.Name())
 	}
@@ -141,3 +156,1225 @@ func TestDDLMaker_parse(t *testing.T) {
 		}
 	})
 }
+
+type BaseModel struct {
+	ID        uint64 `ddl:"auto"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type Address struct {
+	City    string
+	ZipCode string `ddl:"size=8"`
+}
+
+type Customer struct {
+	BaseModel
+	Name     string
+	Home     Address  `ddl:"embedded"`
+	Office   *Address `ddl:"embedded,prefix=work_"`
+	Internal Address  `ddl:"-"`
+}
+
+type SoftDelete struct {
+	DeletedAt sql.NullTime `ddl:"null"`
+}
+
+type Supplier struct {
+	*BaseModel
+	SoftDelete `ddl:"prefix=soft_"`
+	Name       string
+}
+
+type DuplicateCustomer struct {
+	BaseModel
+	ID uint64
+}
+
+type DuplicateAddress struct {
+	Home   Address `ddl:"embedded,prefix=addr_"`
+	Office Address `ddl:"embedded,prefix=addr_"`
+}
+
+type Node struct {
+	*Node
+	Name string
+}
+
+func TestParseFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       interface{}
+		want    []string
+		wantErr error
+	}{
+		{
+			name: "[Normal] embedded struct and named nested struct",
+			s:    Customer{},
+			want: []string{"id", "created_at", "updated_at", "name", "home_city", "home_zip_code", "work_city", "work_zip_code"},
+		},
+		{
+			name: "[Normal] embedded pointer to struct and prefixed embedded struct",
+			s:    Supplier{},
+			want: []string{"id", "created_at", "updated_at", "soft_deleted_at", "name"},
+		},
+		{
+			name:    "[Error] column name collision between embedded and outer field",
+			s:       DuplicateCustomer{},
+			wantErr: ErrDuplicateColumn,
+		},
+		{
+			name:    "[Error] column name collision between named nested structs",
+			s:       DuplicateAddress{},
+			wantErr: ErrDuplicateColumn,
+		},
+		{
+			name:    "[Error] struct embeds itself",
+			s:       Node{},
+			wantErr: ErrRecursiveEmbedding,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := DDLMaker{Dialect: mysql.MySQL{}}
+			if err := dm.AddStruct(tt.s); err != nil {
+				t.Fatal(err)
+			}
+
+			err := dm.parse()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var got []string
+			for _, c := range dm.Tables[0].Columns() {
+				got = append(got, c.Name())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type LegacyUser struct {
+	UserID   uint64 `ddl:"name=userID"`
+	UserName string
+	Home     Address `ddl:"embedded"`
+	Office   Address `ddl:"embedded,prefix=work_"`
+}
+
+func (LegacyUser) Table() string {
+	return "Users"
+}
+
+func TestDDLMaker_parseWithNamingStrategy(t *testing.T) {
+	tests := []struct {
+		name        string
+		ns          NamingStrategy
+		wantTable   string
+		wantColumns []string
+	}{
+		{
+			name:        "[Normal] default naming strategy",
+			wantTable:   "users",
+			wantColumns: []string{"userID", "user_name", "home_city", "home_zip_code", "work_city", "work_zip_code"},
+		},
+		{
+			name:        "[Normal] camelCase",
+			ns:          CamelCase{},
+			wantTable:   "users",
+			wantColumns: []string{"userID", "userName", "homeCity", "homeZipCode", "work_city", "work_zipCode"},
+		},
+		{
+			name:        "[Normal] prefix with verbatim",
+			ns:          PrefixSuffix{Strategy: Verbatim{}, TablePrefix: "tbl_"},
+			wantTable:   "tbl_Users",
+			wantColumns: []string{"userID", "UserName", "HomeCity", "HomeZipCode", "work_City", "work_ZipCode"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := DDLMaker{Dialect: mysql.MySQL{}, config: Config{NamingStrategy: tt.ns}}
+			if err := dm.AddStruct(LegacyUser{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); err != nil {
+				t.Fatal(err)
+			}
+
+			tbl := dm.Tables[0].(table)
+			if tbl.name != tt.wantTable {
+				t.Errorf("table name = %v, want %v", tbl.name, tt.wantTable)
+			}
+			var got []string
+			for _, c := range tbl.Columns() {
+				got = append(got, c.Name())
+			}
+			if !reflect.DeepEqual(got, tt.wantColumns) {
+				t.Errorf("columns = %v, want %v", got, tt.wantColumns)
+			}
+		})
+	}
+}
+
+type Profile struct {
+	ID       uint64
+	Nickname *string
+	Bio      sql.NullString
+	Birthday *time.Time `ddl:"notnull"`
+	Website  string     `ddl:"null"`
+	Twitter  string     `gorm:"not null"`
+	Github   *string    `gorm:"not null"`
+	Avatar   []byte     `ddl:"null"`
+}
+
+func TestDDLMaker_parseWithInferNullability(t *testing.T) {
+	tests := []struct {
+		name             string
+		inferNullability bool
+		want             []string
+	}{
+		{
+			name:             "[Normal] infer nullability from pointer and sql.Null* types",
+			inferNullability: true,
+			want: []string{
+				"`id` BIGINT unsigned NOT NULL",
+				"`nickname` VARCHAR(191) NULL",
+				"`bio` VARCHAR(191) NULL",
+				"`birthday` DATETIME NOT NULL",
+				"`website` VARCHAR(191) NULL",
+				"`twitter` VARCHAR(191) NOT NULL",
+				"`github` VARCHAR(191) NOT NULL",
+				"`avatar` VARBINARY(767) NULL",
+			},
+		},
+		{
+			name:             "[Normal] not infer nullability",
+			inferNullability: false,
+			want: []string{
+				"`id` BIGINT unsigned NOT NULL",
+				"`nickname` VARCHAR(191) NOT NULL",
+				"`bio` VARCHAR(191) NOT NULL",
+				"`birthday` DATETIME NOT NULL",
+				"`website` VARCHAR(191) NULL",
+				"`twitter` VARCHAR(191) NOT NULL",
+				"`github` VARCHAR(191) NOT NULL",
+				"`avatar` VARBINARY(767) NULL",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm := DDLMaker{
+				Dialect: mysql.MySQL{},
+				config:  Config{InferNullability: tt.inferNullability, CompatibleTags: []string{GORMTAG}},
+			}
+			if err := dm.AddStruct(Profile{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, c := range dm.Tables[0].Columns() {
+				sql, err := c.ToSQL()
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, sql)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+
+			wantWarnings := []string{`Profile.Website: string is not nullable type, but tagged "null"`}
+			if !reflect.DeepEqual(dm.Warnings, wantWarnings) {
+				t.Errorf("warnings = %v, want %v", dm.Warnings, wantWarnings)
+			}
+		})
+	}
+}
+
+// Money is custom type registered by RegisterType
+type Money int64
+
+// Point implements driver.Valuer
+type Point struct {
+	X, Y float64
+}
+
+func (p Point) Value() (driver.Value, error) {
+	return fmt.Sprintf("(%f,%f)", p.X, p.Y), nil
+}
+
+// Version implements driver.Valuer by pointer receiver
+type Version struct {
+	Major, Minor int64
+}
+
+func (v *Version) Value() (driver.Value, error) {
+	return v.Major*1000 + v.Minor, nil
+}
+
+type Host struct {
+	ID       uint64
+	Addr     netip.Addr
+	Price    *Money
+	Location Point
+	Version  Version
+	Backup   netip.Addr `ddl:"type=text"`
+}
+
+func TestDDLMaker_parseWithTypeRegistry(t *testing.T) {
+	dm, err := New(Config{DB: DBConfig{Driver: "postgres"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm.RegisterType(reflect.TypeOf(Money(0)), "NUMERIC(19,4)")
+	if err := dm.AddStruct(Host{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range dm.Tables[0].Columns() {
+		sql, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, sql)
+	}
+	want := []string{
+		`"id" BIGINT NOT NULL`,
+		`"addr" INET NOT NULL`,
+		`"price" NUMERIC(19,4) NOT NULL`,
+		`"location" TEXT NOT NULL`,
+		`"version" BIGINT NOT NULL`,
+		`"backup" TEXT NOT NULL`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("columns = %v, want %v", got, want)
+	}
+}
+
+func TestValuerTypeName(t *testing.T) {
+	tests := []struct {
+		name string
+		rt   reflect.Type
+		want string
+	}{
+		{name: "[Normal] value receiver", rt: reflect.TypeOf(Point{}), want: "string"},
+		{name: "[Normal] pointer receiver", rt: reflect.TypeOf(Version{}), want: "int64"},
+		{name: "[Normal] pointer type", rt: reflect.TypeOf(&Version{}), want: "int64"},
+		{name: "[Normal] nil value", rt: reflect.TypeOf(sql.NullString{}), want: ""},
+		{name: "[Normal] not driver.Valuer", rt: reflect.TypeOf(Money(0)), want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := valuerTypeName(tt.rt); got != tt.want {
+				t.Errorf("valuerTypeName() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Optional is user-defined generic wrapper
+type Optional[T any] struct {
+	Value T
+	Valid bool
+}
+
+// Box is user-defined generic wrapper that has only the field of T
+type Box[T any] struct {
+	Value T
+}
+
+// Gen is generic type that has the fields of T, but is not wrapper
+type Gen[T any] struct {
+	A, B T
+}
+
+// Pair is generic type that is not wrapper
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+func TestUnwrapGeneric(t *testing.T) {
+	tests := []struct {
+		name string
+		rt   reflect.Type
+		want reflect.Type
+	}{
+		{name: "[Normal] generic wrapper", rt: reflect.TypeOf(Optional[int64]{}), want: reflect.TypeOf(int64(0))},
+		{name: "[Normal] nested generic wrapper", rt: reflect.TypeOf(Optional[Optional[time.Time]]{}), want: reflect.TypeOf(time.Time{})},
+		{name: "[Normal] pointer to generic wrapper", rt: reflect.TypeOf(&Optional[string]{}), want: reflect.TypeOf(new(string))},
+		{name: "[Normal] generic wrapper of defined type", rt: reflect.TypeOf(Optional[Money]{}), want: reflect.TypeOf(Money(0))},
+		{name: "[Normal] generic wrapper of slice", rt: reflect.TypeOf(Optional[[]byte]{}), want: reflect.TypeOf([]byte{})},
+		{name: "[Normal] generic wrapper that has only the field of T", rt: reflect.TypeOf(Box[int64]{}), want: reflect.TypeOf(int64(0))},
+		{name: "[Normal] not generic wrapper", rt: reflect.TypeOf(Pair[string, int64]{}), want: reflect.TypeOf(Pair[string, int64]{})},
+		{name: "[Normal] generic type that has multiple fields of T", rt: reflect.TypeOf(Gen[int64]{}), want: reflect.TypeOf(Gen[int64]{})},
+		{name: "[Normal] not generic type", rt: reflect.TypeOf(sql.NullString{}), want: reflect.TypeOf(sql.NullString{})},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := unwrapGeneric(tt.rt); got != tt.want {
+				t.Errorf("unwrapGeneric() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type Subscription struct {
+	ID        uint64
+	Plan      Optional[string] `ddl:"size=32"`
+	Price     Optional[Money]
+	ExpiredAt *Optional[time.Time]
+	Note      Optional[string] `ddl:"notnull"`
+}
+
+func TestDDLMaker_parseGenericWrapper(t *testing.T) {
+	dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, InferNullability: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm.RegisterType(reflect.TypeOf(Money(0)), "DECIMAL(19,4)")
+	if err := dm.AddStruct(Subscription{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range dm.Tables[0].Columns() {
+		sql, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, sql)
+	}
+	want := []string{
+		"`id` BIGINT unsigned NOT NULL",
+		"`plan` VARCHAR(32) NULL",
+		"`price` DECIMAL(19,4) NULL",
+		"`expired_at` DATETIME NULL",
+		"`note` VARCHAR(191) NOT NULL",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("columns = %v, want %v", got, want)
+	}
+}
+
+func TestNullableType(t *testing.T) {
+	tests := []struct {
+		name string
+		rt   reflect.Type
+		want bool
+	}{
+		{name: "[Normal] pointer", rt: reflect.TypeOf(new(int64)), want: true},
+		{name: "[Normal] sql.NullString", rt: reflect.TypeOf(sql.NullString{}), want: true},
+		{name: "[Normal] generic wrapper", rt: reflect.TypeOf(Optional[int64]{}), want: true},
+		{name: "[Normal] generic wrapper that has only the field of T", rt: reflect.TypeOf(Box[int64]{}), want: true},
+		{name: "[Normal] generic type that has multiple fields of T", rt: reflect.TypeOf(Gen[int64]{}), want: false},
+		{name: "[Normal] struct named Null*", rt: reflect.TypeOf(NullableName{}), want: false},
+		{name: "[Normal] not nullable", rt: reflect.TypeOf(int64(0)), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nullableType(tt.rt); got != tt.want {
+				t.Errorf("nullableType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// NullableName is not nullable even if the name starts with "Null"
+type NullableName struct {
+	First string
+	Last  string
+}
+
+type Measurement struct {
+	ID     uint64
+	Sample Gen[int64]
+}
+
+func TestDDLMaker_parseGenericNotWrapper(t *testing.T) {
+	dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, InferNullability: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Measurement{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Gen[int64] is not collapsed into the column of int64.
+	sql, err := dm.Tables[0].Columns()[1].ToSQL()
+	if !errors.Is(err, mysql.ErrInvalidType) {
+		t.Errorf("mismatch want=%v, got=%v (%s)", mysql.ErrInvalidType, err, sql)
+	}
+}
+
+// IssueStatus is enum type that declares the allowed values by EnumValues()
+type IssueStatus string
+
+func (IssueStatus) EnumValues() []string {
+	return []string{"open", "closed"}
+}
+
+// Level is enum type that declares the allowed values by the pointer receiver
+type Level string
+
+func (*Level) EnumValues() []string {
+	return []string{"debug", "info"}
+}
+
+func TestEnumValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		rt    reflect.Type
+		specs map[string]string
+		want  []string
+	}{
+		{name: "[Normal] enum tag", rt: reflect.TypeOf(""), specs: map[string]string{"enum": "a|b"}, want: []string{"a", "b"}},
+		{name: "[Normal] enum tag takes precedence", rt: reflect.TypeOf(IssueStatus("")), specs: map[string]string{"enum": "a"}, want: []string{"a"}},
+		{name: "[Normal] value receiver", rt: reflect.TypeOf(IssueStatus("")), specs: map[string]string{}, want: []string{"open", "closed"}},
+		{name: "[Normal] pointer receiver", rt: reflect.TypeOf(Level("")), specs: map[string]string{}, want: []string{"debug", "info"}},
+		{name: "[Normal] pointer type", rt: reflect.TypeOf(new(IssueStatus)), specs: map[string]string{}, want: []string{"open", "closed"}},
+		{name: "[Normal] not enum", rt: reflect.TypeOf(""), specs: map[string]string{}, want: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := enumValues(tt.rt, tt.specs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("enumValues() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnumBaseType(t *testing.T) {
+	tests := []struct {
+		name    string
+		rt      reflect.Type
+		want    reflect.Type
+		wantErr error
+	}{
+		{name: "[Normal] string", rt: reflect.TypeOf(""), want: reflect.TypeOf("")},
+		{name: "[Normal] defined string type", rt: reflect.TypeOf(IssueStatus("")), want: reflect.TypeOf("")},
+		{name: "[Normal] pointer", rt: reflect.TypeOf(new(IssueStatus)), want: reflect.TypeOf(new(string))},
+		{name: "[Normal] sql.NullString", rt: reflect.TypeOf(sql.NullString{}), want: reflect.TypeOf(new(string))},
+		{name: "[Error] int64", rt: reflect.TypeOf(int64(0)), wantErr: ErrInvalidEnumType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enumBaseType(tt.rt)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("enumBaseType() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDDLMaker_parseEnumError(t *testing.T) {
+	type Job struct {
+		Retry int64 `ddl:"enum=1|2"`
+	}
+
+	dm, err := New(Config{DB: DBConfig{Driver: "postgres"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Job{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); !errors.Is(err, ErrInvalidEnumType) {
+		t.Errorf("mismatch want=%v, got=%v", ErrInvalidEnumType, err)
+	}
+}
+
+func TestLiteralKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		rt   reflect.Type
+		want literalKind
+	}{
+		{name: "[Normal] defined string type", rt: reflect.TypeOf(IssueStatus("")), want: stringLiteral},
+		{name: "[Normal] pointer to bool", rt: reflect.TypeOf(new(bool)), want: boolLiteral},
+		{name: "[Normal] uint8", rt: reflect.TypeOf(uint8(0)), want: numberLiteral},
+		{name: "[Normal] sql.NullInt64", rt: reflect.TypeOf(sql.NullInt64{}), want: numberLiteral},
+		{name: "[Normal] sql.NullString", rt: reflect.TypeOf(sql.NullString{}), want: stringLiteral},
+		{name: "[Normal] big.Rat", rt: reflect.TypeOf(big.Rat{}), want: numberLiteral},
+		{name: "[Normal] time.Time is written as is", rt: reflect.TypeOf(time.Time{}), want: rawLiteral},
+		{name: "[Normal] []byte is written as is", rt: reflect.TypeOf([]byte{}), want: rawLiteral},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := literalKindOf(tt.rt); got != tt.want {
+				t.Errorf("literalKindOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDDLMaker_parseDefaultError(t *testing.T) {
+	type Job struct {
+		Retry int64 `ddl:"default=abc"`
+	}
+
+	dm, err := New(Config{DB: DBConfig{Driver: "postgres"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Job{}); err != nil {
+		t.Fatal(err)
+	}
+	err = dm.parse()
+	if !errors.Is(err, ErrInvalidDefault) {
+		t.Fatalf("mismatch want=%v, got=%v", ErrInvalidDefault, err)
+	}
+	if want := `error parse Job: Job.Retry: invalid default value: "abc" is not number`; err.Error() != want {
+		t.Errorf("mismatch want=%s, got=%s", want, err.Error())
+	}
+}
+
+func TestDDLMaker_parseDefaultExprError(t *testing.T) {
+	type Toggle struct {
+		Enabled bool `ddl:"default_expr='yes'"`
+	}
+
+	dm, err := New(Config{DB: DBConfig{Driver: "postgres"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Toggle{}); err != nil {
+		t.Fatal(err)
+	}
+	err = dm.parse()
+	if !errors.Is(err, ErrInvalidDefault) {
+		t.Fatalf("mismatch want=%v, got=%v", ErrInvalidDefault, err)
+	}
+	if want := `error parse Toggle: Toggle.Enabled: invalid default value: string literal 'yes' is not bool`; err.Error() != want {
+		t.Errorf("mismatch want=%s, got=%s", want, err.Error())
+	}
+}
+
+func TestDDLMaker_parseDefaultOfAutoIncrement(t *testing.T) {
+	type Counter struct {
+		ID int64 `ddl:"auto,default=1"`
+	}
+
+	for _, driver := range []string{"duckdb", "mysql"} {
+		t.Run("[Error] default of auto increment column for "+driver, func(t *testing.T) {
+			dm, err := New(Config{DB: DBConfig{Driver: driver}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.AddStruct(Counter{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); !errors.Is(err, ErrInvalidDefault) {
+				t.Errorf("mismatch want=%v, got=%v", ErrInvalidDefault, err)
+			}
+		})
+	}
+}
+
+type Greeting struct {
+	ID        int64
+	Message   string    `ddl:"default='hello, world'"`
+	Amount    float64   `ddl:"type=decimal(10, 2)"`
+	UpdatedAt time.Time `ddl:"default=CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"`
+	Note      *string   `ddl:"null, sise=10"`
+}
+
+func TestDDLMaker_parseTagGrammar(t *testing.T) {
+	dm, err := New(Config{DB: DBConfig{Driver: "mysql"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Greeting{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, c := range dm.Tables[0].Columns() {
+		sql, err := c.ToSQL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, sql)
+	}
+	want := []string{
+		"`id` BIGINT NOT NULL",
+		"`message` VARCHAR(191) NOT NULL DEFAULT 'hello, world'",
+		"`amount` DECIMAL(10,2) NOT NULL",
+		"`updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+		"`note` VARCHAR(191) NULL",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("columns = %v, want %v", got, want)
+	}
+
+	wantWarnings := []string{`Greeting.Note: unknown ddl tag key "sise"`}
+	if !reflect.DeepEqual(dm.Warnings, wantWarnings) {
+		t.Errorf("warnings = %v, want %v", dm.Warnings, wantWarnings)
+	}
+}
+
+func TestDDLMaker_parseInvalidTag(t *testing.T) {
+	type Broken struct {
+		ID   int64
+		Name string `ddl:"default='abc"`
+	}
+
+	dm, err := New(Config{DB: DBConfig{Driver: "mysql"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Broken{}); err != nil {
+		t.Fatal(err)
+	}
+
+	err = dm.parse()
+	if !errors.Is(err, ErrInvalidTag) {
+		t.Fatalf("mismatch want=%v, got=%v", ErrInvalidTag, err)
+	}
+	want := "error parse Broken: Broken.Name: invalid ddl tag: unterminated quote at offset 8 in `default='abc`"
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err.Error(), want)
+	}
+}
+
+type Typo struct {
+	ID    *int64 `ddl:"auto,null"`
+	Name  string `ddl:"sise=10"`
+	Price string `ddl:"size=10,size=20"`
+	Total int64  `ddl:"generated=price * 2,default=0"`
+}
+
+type BadValue struct {
+	Amount float64 `ddl:"size=a"`
+	Rate   float64 `ddl:"precision=2,scale=4"`
+	Memo   string  `ddl:"default='abc"`
+}
+
+func TestDDLMaker_parseStrict(t *testing.T) {
+	t.Run("[Error] all problems are reported at once", func(t *testing.T) {
+		dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, Strict: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dm.AddStruct(Typo{}, BadValue{}); err != nil {
+			t.Fatal(err)
+		}
+
+		err = dm.parse()
+		var tagErrs TagErrors
+		if !errors.As(err, &tagErrs) {
+			t.Fatalf("parse() does not return TagErrors: %v", err)
+		}
+		if len(tagErrs) != 7 {
+			t.Errorf("len(TagErrors) = %d, want 7: %v", len(tagErrs), err)
+		}
+		for _, target := range []error{
+			ErrConflictingTagKeys, ErrUnknownTagKey, ErrDuplicateTagKey, ErrScaleExceedsPrecision, ErrInvalidTag,
+		} {
+			if !errors.Is(err, target) {
+				t.Errorf("parse() error does not have %v: %v", target, err)
+			}
+		}
+	})
+
+	t.Run("[Error] structural problems of all structs are reported at once", func(t *testing.T) {
+		dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, Strict: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dm.AddStruct(DuplicateCustomer{}, OtherPK{}, UnknownCheckColumn{}); err != nil {
+			t.Fatal(err)
+		}
+
+		err = dm.parse()
+		var tagErrs TagErrors
+		if !errors.As(err, &tagErrs) {
+			t.Fatalf("parse() does not return TagErrors: %v", err)
+		}
+		if len(tagErrs) != 3 {
+			t.Errorf("len(TagErrors) = %d, want 3: %v", len(tagErrs), err)
+		}
+		for _, target := range []error{ErrDuplicateColumn, ErrConflictingConstraint, ErrUnknownColumn} {
+			if !errors.Is(err, target) {
+				t.Errorf("parse() error does not have %v: %v", target, err)
+			}
+		}
+	})
+
+	t.Run("[Error] recursive embedding does not stop reporting the other structs", func(t *testing.T) {
+		dm, err := New(Config{DB: DBConfig{Driver: "mysql"}, Strict: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dm.AddStruct(&Node{}, &Typo{}); err != nil {
+			t.Fatal(err)
+		}
+
+		err = dm.parse()
+		for _, target := range []error{ErrRecursiveEmbedding, ErrUnknownTagKey, ErrConflictingTagKeys} {
+			if !errors.Is(err, target) {
+				t.Errorf("parse() error does not have %v: %v", target, err)
+			}
+		}
+	})
+
+	t.Run("[Normal] unknown key and ignored key are warned without strict mode", func(t *testing.T) {
+		dm, err := New(Config{DB: DBConfig{Driver: "mysql"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dm.AddStruct(Typo{}); err != nil {
+			t.Fatal(err)
+		}
+		if err := dm.parse(); err != nil {
+			t.Fatal(err)
+		}
+		want := []string{
+			`Typo.Name: unknown ddl tag key "sise"`,
+			`Typo.Total: "default" is ignored for the generated column`,
+		}
+		if !reflect.DeepEqual(dm.Warnings, want) {
+			t.Errorf("warnings = %v, want %v", dm.Warnings, want)
+		}
+	})
+}
+
+type Membership struct {
+	ID        int64  `ddl:"pk"`
+	UserID    int64  `ddl:"fk=users.id,ondelete=cascade,index=idx_member"`
+	GroupID   int64  `ddl:"fk=groups.id,onupdate=set null,index=idx_member"`
+	Code      string `ddl:"unique"`
+	CreatedAt time.Time
+}
+
+func TestDDLMaker_parseConstraintTags(t *testing.T) {
+	dm, err := New(Config{DB: DBConfig{Driver: "mysql"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.AddStruct(Membership{}); err != nil {
+		t.Fatal(err)
+	}
+	if err := dm.parse(); err != nil {
+		t.Fatal(err)
+	}
+
+	tbl := dm.Tables[0]
+	if got := tbl.PrimaryKey().ToSQL(); got != "PRIMARY KEY (`id`)" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", got)
+	}
+
+	var gotIndexes []string
+	for _, i := range tbl.Indexes() {
+		gotIndexes = append(gotIndexes, i.ToSQL())
+	}
+	wantIndexes := []string{
+		"INDEX `idx_member` (`user_id`, `group_id`)",
+		"UNIQUE `idx_membership_code` (`code`)",
+	}
+	if !reflect.DeepEqual(gotIndexes, wantIndexes) {
+		t.Errorf("indexes = %v, want %v", gotIndexes, wantIndexes)
+	}
+
+	var gotForeignKeys []string
+	for _, fk := range tbl.ForeignKeys() {
+		gotForeignKeys = append(gotForeignKeys, fk.ToSQL())
+	}
+	wantForeignKeys := []string{
+		"FOREIGN KEY (`user_id`) REFERENCES `users` (`id`) ON DELETE CASCADE",
+		"FOREIGN KEY (`group_id`) REFERENCES `groups` (`id`) ON UPDATE SET NULL",
+	}
+	if !reflect.DeepEqual(gotForeignKeys, wantForeignKeys) {
+		t.Errorf("foreign keys = %v, want %v", gotForeignKeys, wantForeignKeys)
+	}
+}
+
+// Booking has the column check constraints and the table check constraint
+type Booking struct {
+	ID       int64
+	Guests   int32  `ddl:"check=guests BETWEEN 1 AND 10"`
+	Status   string `ddl:"size=16,check=status IN ('booked', 'canceled')"`
+	StartAt  time.Time
+	FinishAt time.Time
+}
+
+func (Booking) PrimaryKey() dialect.PrimaryKey {
+	return schema.AddPrimaryKey("id")
+}
+
+func (Booking) Checks() []dialect.Check {
+	return []dialect.Check{
+		schema.AddCheck("chk_booking_period", "start_at < finish_at", "start_at", "finish_at"),
+	}
+}
+
+func TestDDLMaker_parseCheckTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		driver string
+		want   []string
+	}{
+		{
+			name:   "[Normal] check constraints are named chk_<table>_<column> after the declared ones",
+			driver: "mysql",
+			want: []string{
+				"CONSTRAINT `chk_booking_period` CHECK (start_at < finish_at)",
+				"CONSTRAINT `chk_booking_guests` CHECK (guests BETWEEN 1 AND 10)",
+				"CONSTRAINT `chk_booking_status` CHECK (status IN ('booked', 'canceled'))",
+			},
+		},
+		{
+			name:   "[Normal] names are quoted by PostgreSQL",
+			driver: "postgres",
+			want: []string{
+				`CONSTRAINT "chk_booking_period" CHECK (start_at < finish_at)`,
+				`CONSTRAINT "chk_booking_guests" CHECK (guests BETWEEN 1 AND 10)`,
+				`CONSTRAINT "chk_booking_status" CHECK (status IN ('booked', 'canceled'))`,
+			},
+		},
+		{
+			name:   "[Normal] names are quoted by SQL Server",
+			driver: "mssql",
+			want: []string{
+				"CONSTRAINT [chk_booking_period] CHECK (start_at < finish_at)",
+				"CONSTRAINT [chk_booking_guests] CHECK (guests BETWEEN 1 AND 10)",
+				"CONSTRAINT [chk_booking_status] CHECK (status IN ('booked', 'canceled'))",
+			},
+		},
+		{
+			name:   "[Normal] names are upper case in Oracle Database",
+			driver: "oracle",
+			want: []string{
+				`CONSTRAINT "CHK_BOOKING_PERIOD" CHECK (start_at < finish_at)`,
+				`CONSTRAINT "CHK_BOOKING_GUESTS" CHECK (guests BETWEEN 1 AND 10)`,
+				`CONSTRAINT "CHK_BOOKING_STATUS" CHECK (status IN ('booked', 'canceled'))`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := New(Config{DB: DBConfig{Driver: tt.driver}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.AddStruct(Booking{}); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, check := range dm.Tables[0].(Check).Checks() {
+				got = append(got, check.ToSQL())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("checks = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// SamePK declares the same primary key by the method and the tag
+type SamePK struct {
+	ID int64 `ddl:"pk"`
+}
+
+func (SamePK) PrimaryKey() dialect.PrimaryKey {
+	return schema.AddPrimaryKey("id")
+}
+
+// OtherPK declares the different primary key by the method and the tag
+type OtherPK struct {
+	ID   int64 `ddl:"pk"`
+	Code string
+}
+
+func (OtherPK) PrimaryKey() dialect.PrimaryKey {
+	return schema.AddPrimaryKey("code")
+}
+
+// OtherIndex declares the different index of the same name by the method and the tag
+type OtherIndex struct {
+	ID   int64
+	Code string `ddl:"index=code_idx"`
+}
+
+func (OtherIndex) Indexes() dialect.Indexes {
+	return dialect.Indexes{schema.AddUniqueIndex("code_idx", "code")}
+}
+
+// OtherFK declares the different foreign key of the same column by the method and the tag
+type OtherFK struct {
+	ID     int64
+	UserID int64 `ddl:"fk=users.id"`
+}
+
+func (OtherFK) ForeignKeys() dialect.ForeignKeys {
+	return dialect.ForeignKeys{schema.AddForeignKey([]string{"user_id"}, []string{"id"}, "accounts")}
+}
+
+// SameCheck declares the same check constraint by the method and the tag
+type SameCheck struct {
+	ID    int64
+	Price int64 `ddl:"check=price > 0"`
+}
+
+func (SameCheck) Checks() []dialect.Check {
+	return []dialect.Check{schema.AddCheck("chk_same_check_price", "price > 0", "price")}
+}
+
+// OtherCheck declares the different check constraint of the same name by the method and the tag
+type OtherCheck struct {
+	ID    int64
+	Price int64 `ddl:"check=price > 0"`
+}
+
+func (OtherCheck) Checks() []dialect.Check {
+	return []dialect.Check{schema.AddCheck("chk_other_check_price", "price >= 0", "price")}
+}
+
+// UnknownCheckColumn declares the check constraint that references the column the table does not have
+type UnknownCheckColumn struct {
+	ID       int64
+	StartAt  time.Time
+	FinishAt time.Time
+}
+
+func (UnknownCheckColumn) Checks() []dialect.Check {
+	return []dialect.Check{schema.AddCheck("chk_period", "start_at < end_at", "start_at", "end_at")}
+}
+
+func TestDDLMaker_parseConstraintConflict(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       interface{}
+		wantErr error
+	}{
+		{name: "[Normal] same primary key", s: SamePK{}},
+		{name: "[Error] different primary key", s: OtherPK{}, wantErr: ErrConflictingConstraint},
+		{name: "[Error] different uniqueness of index", s: OtherIndex{}, wantErr: ErrConflictingConstraint},
+		{name: "[Error] different reference of foreign key", s: OtherFK{}, wantErr: ErrConflictingConstraint},
+		{name: "[Normal] same check", s: SameCheck{}},
+		{name: "[Error] different expression of check", s: OtherCheck{}, wantErr: ErrConflictingConstraint},
+		{name: "[Error] check references unknown column", s: UnknownCheckColumn{}, wantErr: ErrUnknownColumn},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := New(Config{DB: DBConfig{Driver: "mysql"}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.AddStruct(tt.s); err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.parse(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+// QuotedConstraints declares the index and the foreign key of the dialect by the methods
+// and the tags. The indexes and the foreign keys of the dialect return the quoted identifiers.
+type QuotedConstraints struct {
+	ID          int64
+	Email       string              `ddl:"index=idx_email"`
+	UserID      int64               `ddl:"fk=users.id"`
+	indexes     dialect.Indexes     `ddl:"-"`
+	foreignKeys dialect.ForeignKeys `ddl:"-"`
+}
+
+func (q QuotedConstraints) Indexes() dialect.Indexes {
+	return q.indexes
+}
+
+func (q QuotedConstraints) ForeignKeys() dialect.ForeignKeys {
+	return q.foreignKeys
+}
+
+func TestDDLMaker_parseQuotedConstraintMerge(t *testing.T) {
+	const table = "quoted_constraints"
+	tests := []struct {
+		name    string
+		driver  string
+		s       QuotedConstraints
+		wantErr error
+	}{
+		{
+			name:   "[Normal] same index and foreign key of sqlite",
+			driver: "sqlite",
+			s: QuotedConstraints{
+				indexes:     dialect.Indexes{sqlite.AddIndex("idx_email", table, "email")},
+				foreignKeys: dialect.ForeignKeys{sqlite.AddForeignKey([]string{"user_id"}, []string{"id"}, "users")},
+			},
+		},
+		{
+			name:    "[Error] different uniqueness of index of sqlite",
+			driver:  "sqlite",
+			s:       QuotedConstraints{indexes: dialect.Indexes{sqlite.AddUniqueIndex("idx_email", table, "email")}},
+			wantErr: ErrConflictingConstraint,
+		},
+		{
+			name:   "[Normal] same index and foreign key of postgres",
+			driver: "postgres",
+			s: QuotedConstraints{
+				indexes:     dialect.Indexes{postgres.AddIndex("idx_email", table, "email")},
+				foreignKeys: dialect.ForeignKeys{postgres.AddForeignKey([]string{"user_id"}, []string{"id"}, "users")},
+			},
+		},
+		{
+			name:    "[Error] different reference of foreign key of postgres",
+			driver:  "postgres",
+			s:       QuotedConstraints{foreignKeys: dialect.ForeignKeys{postgres.AddForeignKey([]string{"user_id"}, []string{"id"}, "accounts")}},
+			wantErr: ErrConflictingConstraint,
+		},
+		{
+			name:   "[Normal] same index and foreign key of mssql",
+			driver: "mssql",
+			s: QuotedConstraints{
+				indexes:     dialect.Indexes{mssql.AddIndex("idx_email", table, "email")},
+				foreignKeys: dialect.ForeignKeys{mssql.AddForeignKey([]string{"user_id"}, []string{"id"}, "users")},
+			},
+		},
+		{
+			name:    "[Error] different columns of index of mssql",
+			driver:  "mssql",
+			s:       QuotedConstraints{indexes: dialect.Indexes{mssql.AddIndex("idx_email", table, "id")}},
+			wantErr: ErrConflictingConstraint,
+		},
+		{
+			name:   "[Normal] same index and foreign key of duckdb",
+			driver: "duckdb",
+			s: QuotedConstraints{
+				indexes:     dialect.Indexes{duckdb.AddIndex("idx_email", table, "email")},
+				foreignKeys: dialect.ForeignKeys{duckdb.AddForeignKey([]string{"user_id"}, []string{"id"}, "users")},
+			},
+		},
+		{
+			name:    "[Error] different uniqueness of index of duckdb",
+			driver:  "duckdb",
+			s:       QuotedConstraints{indexes: dialect.Indexes{duckdb.AddUniqueIndex("idx_email", table, "email")}},
+			wantErr: ErrConflictingConstraint,
+		},
+		{
+			name:   "[Normal] same index of cockroach",
+			driver: "cockroach",
+			s:      QuotedConstraints{indexes: dialect.Indexes{cockroach.AddIndex("idx_email", table, "email")}},
+		},
+		{
+			name:    "[Error] different uniqueness of index of cockroach",
+			driver:  "cockroach",
+			s:       QuotedConstraints{indexes: dialect.Indexes{cockroach.AddUniqueIndex("idx_email", table, "email")}},
+			wantErr: ErrConflictingConstraint,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dm, err := New(Config{DB: DBConfig{Driver: tt.driver}})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := dm.AddStruct(tt.s); err != nil {
+				t.Fatal(err)
+			}
+			err = dm.parse()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if err != nil {
+				return
+			}
+			// The constraint declared by both is used once.
+			if got := len(dm.Tables[0].Indexes()); got != 1 {
+				t.Errorf("mismatch number of indexes want=1, got=%d", got)
+			}
+			if got := len(dm.Tables[0].ForeignKeys()); got != 1 {
+				t.Errorf("mismatch number of foreign keys want=1, got=%d", got)
+			}
+		})
+	}
+}
+
+func TestTagConstraint(t *testing.T) {
+	tests := []struct {
+		name    string
+		specs   map[string]string
+		want    fieldConstraint
+		wantErr error
+	}{
+		{
+			name:  "[Normal] primary key",
+			specs: map[string]string{"pk": ""},
+			want:  fieldConstraint{primaryKey: true},
+		},
+		{
+			name:  "[Normal] foreign key with schema",
+			specs: map[string]string{"fk": "public.users.id", "ondelete": "set null"},
+			want: fieldConstraint{foreignKey: &tagForeignKey{
+				referenceTable: "public.users", referenceColumn: "id", onDelete: schema.SetNull,
+			}},
+		},
+		{
+			name:  "[Normal] check",
+			specs: map[string]string{"check": "price > 0"},
+			want:  fieldConstraint{check: "price > 0"},
+		},
+		{
+			name:    "[Error] check without expression",
+			specs:   map[string]string{"check": ""},
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] foreign key without column",
+			specs:   map[string]string{"fk": "users"},
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] referential action without foreign key",
+			specs:   map[string]string{"ondelete": "cascade"},
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] unknown referential action",
+			specs:   map[string]string{"fk": "users.id", "onupdate": "drop"},
+			wantErr: schema.ErrInvalidAction,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tagConstraint(tt.specs)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if err == nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tagConstraint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,35 @@
 package query
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // Quote encloses the string with ``.
 func Quote(s string) string {
 	return fmt.Sprintf("`%s`", s)
 }
+
+// DoubleQuote encloses the string with "".
+func DoubleQuote(s string) string {
+	return fmt.Sprintf("\"%s\"", s)
+}
+
+// Bracket encloses the string with [].
+func Bracket(s string) string {
+	return fmt.Sprintf("[%s]", s)
+}
+
+// SingleQuote encloses the string with single quotes. Single quotes in the string are doubled.
+func SingleQuote(s string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(s, "'", "''"))
+}
+
+// SingleQuoteList return the comma separated list of the strings enclosed with single quotes.
+func SingleQuoteList(ss []string) string {
+	quoted := make([]string, 0, len(ss))
+	for _, s := range ss {
+		quoted = append(quoted, SingleQuote(s))
+	}
+	return strings.Join(quoted, ", ")
+}
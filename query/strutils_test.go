@@ -11,3 +11,39 @@ func TestQuote(t *testing.T) {
 		t.Errorf("mismatch want=%s, got=%s", want, got)
 	}
 }
+
+func TestDoubleQuote(t *testing.T) {
+	column := "id"
+
+	want := `"id"`
+	got := DoubleQuote(column)
+	if want != got {
+		t.Errorf("mismatch want=%s, got=%s", want, got)
+	}
+}
+
+func TestBracket(t *testing.T) {
+	column := "id"
+
+	want := "[id]"
+	got := Bracket(column)
+	if want != got {
+		t.Errorf("mismatch want=%s, got=%s", want, got)
+	}
+}
+
+func TestSingleQuote(t *testing.T) {
+	want := `'it''s'`
+	got := SingleQuote("it's")
+	if want != got {
+		t.Errorf("mismatch want=%s, got=%s", want, got)
+	}
+}
+
+func TestSingleQuoteList(t *testing.T) {
+	want := `'active', 'inactive'`
+	got := SingleQuoteList([]string{"active", "inactive"})
+	if want != got {
+		t.Errorf("mismatch want=%s, got=%s", want, got)
+	}
+}
@@ -0,0 +1,321 @@
+// Package schema provides the dialect-agnostic primary key, index, foreign key and check constraint.
+// They only have the intent (columns, name, uniqueness and referential actions),
+// and each dialect renders them. So one struct definition generates correct DDL
+// for every driver.
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/nao1215/ddl-maker/query"
+)
+
+// Renderer is implemented by the dialects that render dialect-agnostic constraints.
+// If the dialect does not implement it, the constraints are rendered in standard SQL.
+type Renderer interface {
+	PrimaryKeySQL(pk PrimaryKey) string
+	IndexSQL(table string, index Index) string
+	ForeignKeySQL(fk ForeignKey) string
+}
+
+// ErrInvalidAction means the referential action is unknown
+var ErrInvalidAction = errors.New("invalid referential action")
+
+// Action is referential action of foreign key constraint
+type Action string
+
+const (
+	// Cascade CASCADE
+	Cascade Action = "CASCADE"
+	// SetNull SET NULL
+	SetNull Action = "SET NULL"
+	// Restrict RESTRICT
+	Restrict Action = "RESTRICT"
+	// NoAction NO ACTION
+	NoAction Action = "NO ACTION"
+	// SetDefault SET DEFAULT
+	SetDefault Action = "SET DEFAULT"
+)
+
+// String Stringer for Action
+func (a Action) String() string {
+	return string(a)
+}
+
+// ParseAction return Action of s. It is case-insensitive, and the words may be
+// separated by space, underscore or nothing (e.g. "set null", "SET_NULL", "setnull").
+func ParseAction(s string) (Action, error) {
+	normalized := strings.NewReplacer(" ", "", "_", "").Replace(strings.ToUpper(s))
+	for _, a := range []Action{Cascade, SetNull, Restrict, NoAction, SetDefault} {
+		if strings.ReplaceAll(string(a), " ", "") == normalized {
+			return a, nil
+		}
+	}
+	return "", fmt.Errorf("%w: %s", ErrInvalidAction, s)
+}
+
+// PrimaryKey is dialect-agnostic primary key
+type PrimaryKey struct {
+	columns  []string
+	renderer Renderer
+}
+
+// AddPrimaryKey return initialized PrimaryKey struct.
+func AddPrimaryKey(columns ...string) PrimaryKey {
+	return PrimaryKey{
+		columns: columns,
+	}
+}
+
+// Bind return PrimaryKey that is rendered by r.
+func (pk PrimaryKey) Bind(r Renderer) PrimaryKey {
+	pk.renderer = r
+	return pk
+}
+
+// Columns returns the columns that will be the primary keys.
+func (pk PrimaryKey) Columns() []string {
+	return pk.columns
+}
+
+// ToSQL return primary key sql string.
+func (pk PrimaryKey) ToSQL() string {
+	if pk.renderer != nil {
+		return pk.renderer.PrimaryKeySQL(pk)
+	}
+	return fmt.Sprintf("PRIMARY KEY (%s)", strings.Join(quoteAll(pk.columns), ", "))
+}
+
+// Index is dialect-agnostic index
+type Index struct {
+	columns  []string
+	name     string
+	unique   bool
+	table    string
+	renderer Renderer
+}
+
+// AddIndex returns a new Index
+func AddIndex(idxName string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		columns: columns,
+	}
+}
+
+// AddUniqueIndex returns a new unique Index
+func AddUniqueIndex(idxName string, columns ...string) Index {
+	return Index{
+		name:    idxName,
+		columns: columns,
+		unique:  true,
+	}
+}
+
+// Bind return Index of the table that is rendered by r.
+func (i Index) Bind(table string, r Renderer) Index {
+	i.table = table
+	i.renderer = r
+	return i
+}
+
+// Name return index name
+func (i Index) Name() string {
+	return i.name
+}
+
+// Columns return index columns
+func (i Index) Columns() []string {
+	return i.columns
+}
+
+// Unique return whether the index is unique index
+func (i Index) Unique() bool {
+	return i.unique
+}
+
+// ToSQL return index sql string
+func (i Index) ToSQL() string {
+	if i.renderer != nil {
+		return i.renderer.IndexSQL(i.table, i)
+	}
+
+	var unique string
+	if i.unique {
+		unique = "UNIQUE "
+	}
+	return fmt.Sprintf("CREATE %sINDEX %s ON %s (%s);",
+		unique, query.DoubleQuote(i.name), query.DoubleQuote(i.table), strings.Join(quoteAll(i.columns), ", "))
+}
+
+// ForeignKey is dialect-agnostic foreign key
+type ForeignKey struct {
+	foreignColumns     []string
+	referenceTableName string
+	referenceColumns   []string
+	updateOption       Action
+	deleteOption       Action
+	renderer           Renderer
+}
+
+// ForeignKeyOption is an interface for controlling foreign key constraint options.
+type ForeignKeyOption interface {
+	Apply(*ForeignKey)
+}
+
+type onUpdate Action
+
+// Apply apply referential action for Update.
+func (o onUpdate) Apply(f *ForeignKey) {
+	f.updateOption = Action(o)
+}
+
+// OnUpdate return the referential action for Update.
+func OnUpdate(action Action) ForeignKeyOption {
+	return onUpdate(action)
+}
+
+type onDelete Action
+
+// Apply apply referential action for Delete.
+func (o onDelete) Apply(f *ForeignKey) {
+	f.deleteOption = Action(o)
+}
+
+// OnDelete return the referential action for Delete.
+func OnDelete(action Action) ForeignKeyOption {
+	return onDelete(action)
+}
+
+// AddForeignKey returns a new ForeignKey
+func AddForeignKey(foreignColumns, referenceColumns []string, referenceTableName string, option ...ForeignKeyOption) ForeignKey {
+	foreignKey := ForeignKey{
+		foreignColumns:     foreignColumns,
+		referenceTableName: referenceTableName,
+		referenceColumns:   referenceColumns,
+	}
+
+	for _, o := range option {
+		if o != nil {
+			o.Apply(&foreignKey)
+		}
+	}
+	return foreignKey
+}
+
+// Bind return ForeignKey that is rendered by r.
+func (fk ForeignKey) Bind(r Renderer) ForeignKey {
+	fk.renderer = r
+	return fk
+}
+
+// ForeignColumns return slice of foreign key columns
+func (fk ForeignKey) ForeignColumns() []string {
+	return fk.foreignColumns
+}
+
+// ReferenceTableName return reference table name
+func (fk ForeignKey) ReferenceTableName() string {
+	return fk.referenceTableName
+}
+
+// ReferenceColumns return slice of return foreign key columns
+func (fk ForeignKey) ReferenceColumns() []string {
+	return fk.referenceColumns
+}
+
+// UpdateOption return referential action string for update.
+// If the action is not specified, it returns empty string.
+func (fk ForeignKey) UpdateOption() string {
+	return fk.updateOption.String()
+}
+
+// DeleteOption return referential action string for delete.
+// If the action is not specified, it returns empty string.
+func (fk ForeignKey) DeleteOption() string {
+	return fk.deleteOption.String()
+}
+
+// ToSQL return foreign key sql string
+func (fk ForeignKey) ToSQL() string {
+	if fk.renderer != nil {
+		return fk.renderer.ForeignKeySQL(fk)
+	}
+
+	sql := fmt.Sprintf("FOREIGN KEY (%s) REFERENCES %s (%s)",
+		strings.Join(quoteAll(fk.foreignColumns), ", "),
+		query.DoubleQuote(fk.referenceTableName),
+		strings.Join(quoteAll(fk.referenceColumns), ", "))
+	if fk.UpdateOption() != "" {
+		sql += fmt.Sprintf(" ON UPDATE %s", fk.UpdateOption())
+	}
+	if fk.DeleteOption() != "" {
+		sql += fmt.Sprintf(" ON DELETE %s", fk.DeleteOption())
+	}
+	return sql
+}
+
+// Quoter quotes the identifier. Every dialect implements it.
+type Quoter interface {
+	Quote(string) string
+}
+
+// Check is dialect-agnostic named CHECK constraint. The SQL of it is the same
+// in every dialect except the quote of the name.
+type Check struct {
+	name       string
+	expression string
+	columns    []string
+	quoter     Quoter
+}
+
+// AddCheck returns a new Check. expression is written in CHECK (...) as is, and
+// columns are the columns referenced by expression. They are validated that
+// the table has them.
+func AddCheck(name, expression string, columns ...string) Check {
+	return Check{
+		name:       name,
+		expression: expression,
+		columns:    columns,
+	}
+}
+
+// Bind return Check whose name is quoted by q.
+func (c Check) Bind(q Quoter) Check {
+	c.quoter = q
+	return c
+}
+
+// Name return check constraint name
+func (c Check) Name() string {
+	return c.name
+}
+
+// Expression return the condition of the check constraint
+func (c Check) Expression() string {
+	return c.expression
+}
+
+// Columns return the columns referenced by the condition
+func (c Check) Columns() []string {
+	return c.columns
+}
+
+// ToSQL return check constraint sql string
+func (c Check) ToSQL() string {
+	name := query.DoubleQuote(c.name)
+	if c.quoter != nil {
+		name = c.quoter.Quote(c.name)
+	}
+	return fmt.Sprintf("CONSTRAINT %s CHECK (%s)", name, c.expression)
+}
+
+func quoteAll(ss []string) []string {
+	var quoted []string
+	for _, s := range ss {
+		quoted = append(quoted, query.DoubleQuote(s))
+	}
+	return quoted
+}
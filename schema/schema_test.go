@@ -0,0 +1,163 @@
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// bracketRenderer is Renderer for test
+type bracketRenderer struct{}
+
+func (r bracketRenderer) PrimaryKeySQL(pk PrimaryKey) string {
+	return fmt.Sprintf("PK [%s]", strings.Join(pk.Columns(), "], ["))
+}
+
+func (r bracketRenderer) IndexSQL(table string, index Index) string {
+	return fmt.Sprintf("INDEX [%s] ON [%s] unique=%t", index.Name(), table, index.Unique())
+}
+
+func (r bracketRenderer) ForeignKeySQL(fk ForeignKey) string {
+	return fmt.Sprintf("FK [%s] update=%s delete=%s", fk.ReferenceTableName(), fk.UpdateOption(), fk.DeleteOption())
+}
+
+func TestAddPrimaryKey(t *testing.T) {
+	pk := AddPrimaryKey("id", "created_at")
+	if pk.ToSQL() != `PRIMARY KEY ("id", "created_at")` {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+
+	pk = pk.Bind(bracketRenderer{})
+	if pk.ToSQL() != "PK [id], [created_at]" {
+		t.Errorf("PrimaryKey.ToSQL() = %v", pk.ToSQL())
+	}
+}
+
+func TestAddIndex(t *testing.T) {
+	tests := []struct {
+		name  string
+		index Index
+		want  string
+	}{
+		{
+			name:  "[Normal] index in standard sql",
+			index: AddIndex("name_idx", "name").Bind("player", nil),
+			want:  `CREATE INDEX "name_idx" ON "player" ("name");`,
+		},
+		{
+			name:  "[Normal] unique index in standard sql",
+			index: AddUniqueIndex("name_uniq_idx", "name", "entry_id").Bind("player", nil),
+			want:  `CREATE UNIQUE INDEX "name_uniq_idx" ON "player" ("name", "entry_id");`,
+		},
+		{
+			name:  "[Normal] index rendered by dialect",
+			index: AddIndex("name_idx", "name").Bind("player", bracketRenderer{}),
+			want:  "INDEX [name_idx] ON [player] unique=false",
+		},
+		{
+			name:  "[Normal] unique index rendered by dialect",
+			index: AddUniqueIndex("name_uniq_idx", "name").Bind("player", bracketRenderer{}),
+			want:  "INDEX [name_uniq_idx] ON [player] unique=true",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.index.ToSQL(); got != tt.want {
+				t.Errorf("Index.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddForeignKey(t *testing.T) {
+	tests := []struct {
+		name string
+		fk   ForeignKey
+		want string
+	}{
+		{
+			name: "[Normal] no option",
+			fk:   AddForeignKey([]string{"player_id"}, []string{"id"}, "player"),
+			want: `FOREIGN KEY ("player_id") REFERENCES "player" ("id")`,
+		},
+		{
+			name: "[Normal] referential actions",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				OnUpdate(Cascade), OnDelete(SetNull)),
+			want: `FOREIGN KEY ("player_id") REFERENCES "player" ("id") ON UPDATE CASCADE ON DELETE SET NULL`,
+		},
+		{
+			name: "[Normal] foreign key rendered by dialect",
+			fk: AddForeignKey([]string{"player_id"}, []string{"id"}, "player",
+				OnDelete(Restrict)).Bind(bracketRenderer{}),
+			want: "FK [player] update= delete=RESTRICT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fk.ToSQL(); got != tt.want {
+				t.Errorf("ForeignKey.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAction(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Action
+		wantErr error
+	}{
+		{name: "[Normal] lower case", s: "cascade", want: Cascade},
+		{name: "[Normal] separated by space", s: "set null", want: SetNull},
+		{name: "[Normal] separated by underscore", s: "NO_ACTION", want: NoAction},
+		{name: "[Normal] not separated", s: "setdefault", want: SetDefault},
+		{name: "[Error] unknown action", s: "drop", wantErr: ErrInvalidAction},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAction(tt.s)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAction() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// bracketQuoter is Quoter for test
+type bracketQuoter struct{}
+
+func (q bracketQuoter) Quote(s string) string {
+	return "[" + s + "]"
+}
+
+func TestAddCheck(t *testing.T) {
+	tests := []struct {
+		name  string
+		check Check
+		want  string
+	}{
+		{
+			name:  "[Normal] check in standard sql",
+			check: AddCheck("chk_price", "price > 0", "price"),
+			want:  `CONSTRAINT "chk_price" CHECK (price > 0)`,
+		},
+		{
+			name:  "[Normal] check quoted by dialect",
+			check: AddCheck("chk_period", "start_at < end_at", "start_at", "end_at").Bind(bracketQuoter{}),
+			want:  "CONSTRAINT [chk_period] CHECK (start_at < end_at)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.check.ToSQL(); got != tt.want {
+				t.Errorf("Check.ToSQL() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package ddlmaker
 
 import (
 	"github.com/nao1215/ddl-maker/dialect"
+	"github.com/nao1215/ddl-maker/query"
 )
 
 // Table is mapping struct info
@@ -12,6 +13,10 @@ type table struct {
 	columns     []dialect.Column
 	indexes     dialect.Indexes
 	dialect     dialect.Dialect
+	// comment is the table comment returned by TableComment().
+	comment string
+	// checks is the named check constraints declared by Checks() and "check" tags.
+	checks []dialect.Check
 }
 
 func newTable(name string, pk dialect.PrimaryKey, fks dialect.ForeignKeys, columns []dialect.Column, indexes dialect.Indexes, d dialect.Dialect) table {
@@ -48,3 +53,18 @@ func (t table) Indexes() dialect.Indexes {
 func (t table) Dialect() dialect.Dialect {
 	return t.dialect
 }
+
+// Checks return the named check constraints of the table.
+func (t table) Checks() []dialect.Check {
+	return t.checks
+}
+
+// Comment return the table comment. If it is not declared, return empty string.
+func (t table) Comment() string {
+	return t.comment
+}
+
+// QuotedComment return the table comment as the string literal.
+func (t table) QuotedComment() string {
+	return query.SingleQuote(t.comment)
+}
@@ -0,0 +1,211 @@
+package ddlmaker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// knownTagKeys is the keys of "ddl" tag that ddlmaker understands.
+var knownTagKeys = map[string]bool{
+	IGNORETAG:      true,
+	EMBEDDEDTAG:    true,
+	NAMETAG:        true,
+	PREFIXTAG:      true,
+	ENUMTAG:        true,
+	"null":         true,
+	"notnull":      true,
+	"auto":         true,
+	"type":         true,
+	"size":         true,
+	"precision":    true,
+	"scale":        true,
+	"default":      true,
+	"default_expr": true,
+	"pk":           true,
+	"index":        true,
+	"unique":       true,
+	"fk":           true,
+	"onupdate":     true,
+	"ondelete":     true,
+	"comment":      true,
+	"check":        true,
+	"generated":    true,
+	"storage":      true,
+}
+
+// tagKeyProvider is for type assertion. A dialect implements it when the
+// table template uses the dialect-specific tag keys (e.g. "invisible" for MariaDB).
+type tagKeyProvider interface {
+	TagKeys() []string
+}
+
+// tagSpec is the element of "ddl" tag. value is empty if the key has no value.
+type tagSpec struct {
+	key   string
+	value string
+}
+
+// parseTag parses "ddl" tag that is the comma separated list of "key" or "key=value".
+//   - Spaces around keys and values are ignored. Spaces in values are kept
+//     (e.g. default=CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP).
+//   - Commas in quotes and parentheses do not separate the elements
+//     (e.g. default='hello, world', type=decimal(10,2)). Quotes are kept in the value.
+//     A quote in the quoted value is escaped by doubling it or by backslash.
+//   - Outside quotes, backslash escapes the next character (e.g. default=a\,b is "a,b").
+func parseTag(tag string) ([]tagSpec, error) {
+	var specs []tagSpec
+	var p tagParser
+
+	for i, r := range tag {
+		switch {
+		case p.escaped:
+			p.write(r, true)
+			p.escaped = false
+		case p.quote != 0:
+			p.write(r, true)
+			if r == '\\' {
+				p.escaped = true
+			} else if r == p.quote {
+				p.quote = 0
+			}
+		case r == '\\':
+			p.escaped = true
+		case r == '\'' || r == '"':
+			p.quote, p.quoteAt = r, i
+			p.write(r, true)
+		case r == '(':
+			if p.depth == 0 {
+				p.parenAt = i
+			}
+			p.depth++
+			p.write(r, true)
+		case r == ')':
+			if p.depth == 0 {
+				return nil, fmt.Errorf("%w: unbalanced ')' at offset %d in `%s`", ErrInvalidTag, i, tag)
+			}
+			p.depth--
+			p.write(r, true)
+		case r == ',' && p.depth == 0:
+			spec, err := p.spec()
+			if err != nil {
+				return nil, fmt.Errorf("%w at offset %d in `%s`", err, i, tag)
+			}
+			specs = append(specs, spec)
+			p = tagParser{}
+		case r == '=' && p.depth == 0 && !p.hasKey:
+			p.key, p.hasKey = p.text(), true
+			p.reset()
+		default:
+			p.write(r, !unicode.IsSpace(r))
+		}
+	}
+
+	switch {
+	case p.escaped:
+		return nil, fmt.Errorf("%w: trailing backslash in `%s`", ErrInvalidTag, tag)
+	case p.quote != 0:
+		return nil, fmt.Errorf("%w: unterminated quote at offset %d in `%s`", ErrInvalidTag, p.quoteAt, tag)
+	case p.depth != 0:
+		return nil, fmt.Errorf("%w: unclosed '(' at offset %d in `%s`", ErrInvalidTag, p.parenAt, tag)
+	}
+	if len(specs) == 0 && !p.hasKey && p.text() == "" {
+		return nil, nil
+	}
+	spec, err := p.spec()
+	if err != nil {
+		return nil, fmt.Errorf("%w at the end of `%s`", err, tag)
+	}
+	return append(specs, spec), nil
+}
+
+// unquote return the tag value without the enclosing quotes. The quotes escaped by
+// doubling or backslash in it are unescaped. If value is not quoted, return it as is.
+func unquote(value string) string {
+	if len(value) < 2 {
+		return value
+	}
+	q := value[:1]
+	if (q != "'" && q != `"`) || !strings.HasSuffix(value, q) {
+		return value
+	}
+	return strings.NewReplacer(`\\`, `\`, `\`+q, q, q+q, q).Replace(value[1 : len(value)-1])
+}
+
+// tagParser is the state of parseTag for the current element.
+type tagParser struct {
+	buf strings.Builder
+	// end is the length of buf without trailing spaces.
+	end     int
+	key     string
+	hasKey  bool
+	escaped bool
+	quote   rune
+	quoteAt int
+	depth   int
+	parenAt int
+}
+
+// write writes r. The leading spaces are skipped, and the trailing spaces are
+// trimmed unless significant is true.
+func (p *tagParser) write(r rune, significant bool) {
+	if !significant && p.buf.Len() == 0 {
+		return
+	}
+	p.buf.WriteRune(r)
+	if significant {
+		p.end = p.buf.Len()
+	}
+}
+
+// text return the written string without trailing spaces.
+func (p *tagParser) text() string {
+	return p.buf.String()[:p.end]
+}
+
+func (p *tagParser) reset() {
+	p.buf.Reset()
+	p.end = 0
+}
+
+// spec return the current element.
+func (p *tagParser) spec() (tagSpec, error) {
+	spec := tagSpec{key: p.text()}
+	if p.hasKey {
+		spec = tagSpec{key: p.key, value: p.text()}
+	}
+
+	if spec.key == "" {
+		return spec, fmt.Errorf("%w: empty key", ErrInvalidTag)
+	}
+	for _, r := range spec.key {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' && r != '-' {
+			return spec, fmt.Errorf("%w: invalid key %q", ErrInvalidTag, spec.key)
+		}
+	}
+	return spec, nil
+}
+
+// TagErrors is the problems of "ddl" tags of all structs found in strict mode.
+type TagErrors []error
+
+// Error return the problems separated by newline.
+func (e TagErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return fmt.Sprintf("%d ddl tag error(s):\n%s", len(e), strings.Join(msgs, "\n"))
+}
+
+// Is return whether one of the problems matches target. It makes errors.Is work
+// without the multiple error unwrapping of Go 1.20.
+func (e TagErrors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
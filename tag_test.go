@@ -0,0 +1,118 @@
+package ddlmaker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		tag     string
+		want    []tagSpec
+		wantErr error
+	}{
+		{
+			name: "[Normal] empty tag",
+			tag:  "",
+			want: nil,
+		},
+		{
+			name: "[Normal] keys and values",
+			tag:  "size=10,null,default=0",
+			want: []tagSpec{{key: "size", value: "10"}, {key: "null"}, {key: "default", value: "0"}},
+		},
+		{
+			name: "[Normal] spaces around keys and values are ignored",
+			tag:  " size = 10 , null ",
+			want: []tagSpec{{key: "size", value: "10"}, {key: "null"}},
+		},
+		{
+			name: "[Normal] spaces in value are kept",
+			tag:  "default=CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP",
+			want: []tagSpec{{key: "default", value: "CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP"}},
+		},
+		{
+			name: "[Normal] comma in quotes",
+			tag:  "default='hello, world',null",
+			want: []tagSpec{{key: "default", value: "'hello, world'"}, {key: "null"}},
+		},
+		{
+			name: "[Normal] doubled quote in quotes",
+			tag:  "default='it''s, ok'",
+			want: []tagSpec{{key: "default", value: "'it''s, ok'"}},
+		},
+		{
+			name: "[Normal] escaped quote in quotes",
+			tag:  `default='it\'s, ok'`,
+			want: []tagSpec{{key: "default", value: `'it\'s, ok'`}},
+		},
+		{
+			name: "[Normal] equal sign in value",
+			tag:  "default='a=b'",
+			want: []tagSpec{{key: "default", value: "'a=b'"}},
+		},
+		{
+			name: "[Normal] comma in parentheses",
+			tag:  "type=decimal(10, 2),notnull",
+			want: []tagSpec{{key: "type", value: "decimal(10, 2)"}, {key: "notnull"}},
+		},
+		{
+			name: "[Normal] escaped comma and space",
+			tag:  `default=a\,b\ `,
+			want: []tagSpec{{key: "default", value: "a,b "}},
+		},
+		{
+			name: "[Normal] empty value",
+			tag:  "default=",
+			want: []tagSpec{{key: "default"}},
+		},
+		{
+			name:    "[Error] unterminated quote",
+			tag:     "default='abc,null",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] unclosed parenthesis",
+			tag:     "type=decimal(10,2",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] unbalanced parenthesis",
+			tag:     "type=decimal10,2)",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] trailing backslash",
+			tag:     `default=abc\`,
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] empty element",
+			tag:     "null,,size=10",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] empty key",
+			tag:     "=10",
+			wantErr: ErrInvalidTag,
+		},
+		{
+			name:    "[Error] key has space",
+			tag:     "not null",
+			wantErr: ErrInvalidTag,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTag(tt.tag)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("mismatch want=%v, got=%v", tt.wantErr, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseTag() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,11 @@
+
+DROP TABLE IF EXISTS `journal`;
+
+CREATE TABLE `journal` (
+    `id` Int64 COMMENT 'Journal ID',
+    `title` String COMMENT 'It''s the title, not the body',
+    `body` Nullable(String)
+) ENGINE = MergeTree()
+ORDER BY (`id`)
+COMMENT 'User''s journals (C:\\journals)';
+
@@ -0,0 +1,25 @@
+
+DROP TABLE IF EXISTS `page_view`;
+
+CREATE TABLE `page_view` (
+    `id` UInt64,
+    `user_id` Nullable(UInt64),
+    `path` String,
+    `country` LowCardinality(String),
+    `referrer` String NULL,
+    `duration` Float32 DEFAULT 0,
+    `created_at` DateTime64(3),
+    INDEX `duration_idx` (`duration`) TYPE minmax GRANULARITY 1,
+    INDEX `path_idx` (`path`) TYPE bloom_filter GRANULARITY 4
+) ENGINE = MergeTree()
+ORDER BY (`created_at`, `id`) PARTITION BY toYYYYMM(created_at);
+
+
+DROP TABLE IF EXISTS `metric`;
+
+CREATE TABLE `metric` (
+    `name` String,
+    `value` Float64
+) ENGINE = MergeTree()
+ORDER BY tuple();
+
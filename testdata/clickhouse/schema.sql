@@ -0,0 +1,14 @@
+
+DROP TABLE IF EXISTS `order`;
+
+CREATE TABLE `order` (
+    `id` Int64,
+    `customer_id` Int64,
+    `code` String,
+    `note` String NULL,
+    `created_at` DateTime64(3),
+    INDEX `code_uniq_idx` (`code`) TYPE minmax GRANULARITY 1,
+    INDEX `created_at_idx` (`created_at`) TYPE minmax GRANULARITY 1
+) ENGINE = MergeTree()
+ORDER BY (`id`);
+
@@ -0,0 +1,17 @@
+
+DROP TABLE IF EXISTS "event" CASCADE;
+
+CREATE TABLE "event" (
+    "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+    "seq" INT8 NOT NULL DEFAULT unique_rowid(),
+    "account_id" INT8 NOT NULL,
+    "kind" STRING(32) NOT NULL,
+    "labels" STRING[] NULL,
+    "payload" JSONB NULL,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT now(),
+    FOREIGN KEY ("account_id") REFERENCES "account" ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "event_created_at_idx" ON "event" ("created_at") USING HASH STORING ("kind", "payload") WITH (bucket_count = 8);
+CREATE UNIQUE INDEX "event_seq_uniq_idx" ON "event" ("seq");
@@ -0,0 +1,15 @@
+
+DROP TABLE IF EXISTS "order" CASCADE;
+
+CREATE TABLE "order" (
+    "id" INT8 NOT NULL,
+    "customer_id" INT8 NOT NULL,
+    "code" STRING(32) NOT NULL,
+    "note" STRING NULL,
+    "created_at" TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY ("customer_id") REFERENCES "customer" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "created_at_idx" ON "order" ("created_at");
+CREATE UNIQUE INDEX "code_uniq_idx" ON "order" ("code");
@@ -0,0 +1,13 @@
+
+DROP TABLE IF EXISTS "journal";
+
+CREATE TABLE "journal" (
+    "id" BIGINT NOT NULL,
+    "title" VARCHAR NOT NULL,
+    "body" VARCHAR NULL,
+    PRIMARY KEY ("id")
+);
+COMMENT ON TABLE "journal" IS 'User''s journals (C:\journals)';
+COMMENT ON COLUMN "journal"."id" IS 'Journal ID';
+COMMENT ON COLUMN "journal"."title" IS 'It''s the title, not the body';
+
@@ -0,0 +1,18 @@
+
+DROP TABLE IF EXISTS "sale";
+DROP SEQUENCE IF EXISTS "sale_id_seq";
+CREATE SEQUENCE "sale_id_seq" START 1;
+
+CREATE TABLE "sale" (
+    "id" BIGINT NOT NULL DEFAULT nextval('sale_id_seq'),
+    "store_id" UINTEGER NOT NULL,
+    "total" HUGEINT NOT NULL,
+    "tags" VARCHAR[] NOT NULL,
+    "payload" BLOB NULL,
+    "sold_at" TIMESTAMP NOT NULL,
+    "refunded" BOOLEAN NOT NULL DEFAULT FALSE,
+    FOREIGN KEY ("store_id") REFERENCES "store" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "store_id_sold_at_idx" ON "sale" ("store_id", "sold_at");
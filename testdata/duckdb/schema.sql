@@ -0,0 +1,15 @@
+
+DROP TABLE IF EXISTS "order";
+
+CREATE TABLE "order" (
+    "id" BIGINT NOT NULL,
+    "customer_id" BIGINT NOT NULL,
+    "code" VARCHAR(32) NOT NULL,
+    "note" VARCHAR NULL,
+    "created_at" TIMESTAMP NOT NULL,
+    FOREIGN KEY ("customer_id") REFERENCES "customer" ("id"),
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "created_at_idx" ON "order" ("created_at");
+CREATE UNIQUE INDEX "code_uniq_idx" ON "order" ("code");
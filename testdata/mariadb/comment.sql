@@ -0,0 +1,12 @@
+SET foreign_key_checks=0;
+
+DROP TABLE IF EXISTS `journal`;
+
+CREATE TABLE `journal` (
+    `id` BIGINT NOT NULL COMMENT 'Journal ID',
+    `title` VARCHAR(191) NOT NULL COMMENT 'It''s the title, not the body',
+    `body` VARCHAR(191) NULL,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4 COMMENT 'User''s journals (C:\\journals)';
+
+SET foreign_key_checks=1;
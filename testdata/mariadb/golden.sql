@@ -0,0 +1,19 @@
+SET foreign_key_checks=0;
+
+DROP TABLE IF EXISTS `ticket`;
+DROP SEQUENCE IF EXISTS `ticket_number_seq`;
+CREATE SEQUENCE `ticket_number_seq` START WITH 1 INCREMENT BY 1;
+
+CREATE TABLE `ticket` (
+    `id` UUID NOT NULL,
+    `number` BIGINT NOT NULL DEFAULT NEXTVAL(`ticket_number_seq`),
+    `client_ip` INET6 NOT NULL,
+    `detail` LONGTEXT NULL,
+    `memo` VARCHAR(191) NULL INVISIBLE,
+    `created_at` DATETIME NOT NULL,
+    CHECK (JSON_VALID(`detail`)),
+    UNIQUE `number_uniq_idx` (`number`),
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+SET foreign_key_checks=1;
@@ -0,0 +1,11 @@
+SET foreign_key_checks=0;
+
+DROP TABLE IF EXISTS `device`;
+
+CREATE TABLE `device` (
+    `id` UUID NOT NULL,
+    `address` INET6 NOT NULL,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+SET foreign_key_checks=1;
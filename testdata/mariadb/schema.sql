@@ -0,0 +1,17 @@
+SET foreign_key_checks=0;
+
+DROP TABLE IF EXISTS `order`;
+
+CREATE TABLE `order` (
+    `id` BIGINT NOT NULL,
+    `customer_id` BIGINT NOT NULL,
+    `code` VARCHAR(32) NOT NULL,
+    `note` VARCHAR(191) NULL,
+    `created_at` DATETIME NOT NULL,
+    INDEX `created_at_idx` (`created_at`),
+    UNIQUE `code_uniq_idx` (`code`),
+    FOREIGN KEY (`customer_id`) REFERENCES `customer` (`id`) ON DELETE SET NULL ON UPDATE CASCADE,
+    PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARACTER SET utf8mb4;
+
+SET foreign_key_checks=1;
@@ -0,0 +1,19 @@
+
+IF OBJECT_ID(N'[journal]', N'U') IS NOT NULL
+    DROP TABLE [journal];
+GO
+
+CREATE TABLE [journal] (
+    [id] BIGINT NOT NULL,
+    [title] NVARCHAR(255) NOT NULL,
+    [body] NVARCHAR(255) NULL,
+    PRIMARY KEY ([id])
+);
+GO
+EXEC sp_addextendedproperty N'MS_Description', N'User''s journals (C:\journals)', N'SCHEMA', N'dbo', N'TABLE', N'journal';
+GO
+EXEC sp_addextendedproperty N'MS_Description', N'Journal ID', N'SCHEMA', N'dbo', N'TABLE', N'journal', N'COLUMN', N'id';
+GO
+EXEC sp_addextendedproperty N'MS_Description', N'It''s the title, not the body', N'SCHEMA', N'dbo', N'TABLE', N'journal', N'COLUMN', N'title';
+GO
+
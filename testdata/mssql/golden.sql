@@ -0,0 +1,21 @@
+
+IF OBJECT_ID(N'[invoice]', N'U') IS NOT NULL
+    DROP TABLE [invoice];
+GO
+
+CREATE TABLE [invoice] (
+    [id] BIGINT NOT NULL IDENTITY(1,1),
+    [customer_id] BIGINT NOT NULL,
+    [amount] FLOAT NOT NULL,
+    [paid] BIT NOT NULL DEFAULT 0,
+    [note] NVARCHAR(MAX) NULL,
+    [issued_at] DATETIME2 NOT NULL,
+    FOREIGN KEY ([customer_id]) REFERENCES [customer] ([id]) ON DELETE CASCADE,
+    PRIMARY KEY ([id])
+);
+GO
+
+CREATE INDEX [customer_id_idx] ON [invoice] ([customer_id]);
+GO
+CREATE UNIQUE INDEX [customer_id_issued_at_uniq_idx] ON [invoice] ([customer_id], [issued_at]);
+GO
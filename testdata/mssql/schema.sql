@@ -0,0 +1,20 @@
+
+IF OBJECT_ID(N'[order]', N'U') IS NOT NULL
+    DROP TABLE [order];
+GO
+
+CREATE TABLE [order] (
+    [id] BIGINT NOT NULL,
+    [customer_id] BIGINT NOT NULL,
+    [code] NVARCHAR(32) NOT NULL,
+    [note] NVARCHAR(255) NULL,
+    [created_at] DATETIME2 NOT NULL,
+    FOREIGN KEY ([customer_id]) REFERENCES [customer] ([id]) ON DELETE SET NULL ON UPDATE CASCADE,
+    PRIMARY KEY ([id])
+);
+GO
+
+CREATE INDEX [created_at_idx] ON [order] ([created_at]);
+GO
+CREATE UNIQUE INDEX [code_uniq_idx] ON [order] ([code]);
+GO
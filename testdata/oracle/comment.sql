@@ -0,0 +1,21 @@
+
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE "JOURNAL" CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE "JOURNAL" (
+    "ID" NUMBER(19) NOT NULL,
+    "TITLE" VARCHAR2(255 CHAR) NOT NULL,
+    "BODY" VARCHAR2(255 CHAR) NULL,
+    PRIMARY KEY ("ID")
+);
+COMMENT ON TABLE "JOURNAL" IS 'User''s journals (C:\journals)';
+COMMENT ON COLUMN "JOURNAL"."ID" IS 'Journal ID';
+COMMENT ON COLUMN "JOURNAL"."TITLE" IS 'It''s the title, not the body';
+
@@ -0,0 +1,42 @@
+
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE "DEPARTMENT" CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE "DEPARTMENT" (
+    "ID" NUMBER(19) GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "NAME" VARCHAR2(100 CHAR) NOT NULL,
+    "BUDGET" BINARY_DOUBLE NOT NULL,
+    "ACTIVE" NUMBER(1) DEFAULT 1 NOT NULL,
+    "CREATED_AT" TIMESTAMP NOT NULL,
+    PRIMARY KEY ("ID")
+);
+
+CREATE UNIQUE INDEX "DEPARTMENT_NAME_UNIQ_IDX" ON "DEPARTMENT" ("NAME");
+
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE "EMPLOYEE" CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE "EMPLOYEE" (
+    "ID" NUMBER(19) GENERATED BY DEFAULT AS IDENTITY NOT NULL,
+    "DEPARTMENT_ID" NUMBER(19) NULL,
+    "NAME" VARCHAR2(255 CHAR) NOT NULL,
+    "RESUME" CLOB NULL,
+    "HIRED_AT" TIMESTAMP NOT NULL,
+    FOREIGN KEY ("DEPARTMENT_ID") REFERENCES "DEPARTMENT" ("ID") ON DELETE SET NULL,
+    PRIMARY KEY ("ID")
+);
+
@@ -0,0 +1,23 @@
+
+BEGIN
+    EXECUTE IMMEDIATE 'DROP TABLE "ORDER" CASCADE CONSTRAINTS';
+EXCEPTION
+    WHEN OTHERS THEN
+        IF SQLCODE != -942 THEN
+            RAISE;
+        END IF;
+END;
+/
+
+CREATE TABLE "ORDER" (
+    "ID" NUMBER(19) NOT NULL,
+    "CUSTOMER_ID" NUMBER(19) NOT NULL,
+    "CODE" VARCHAR2(32 CHAR) NOT NULL,
+    "NOTE" VARCHAR2(255 CHAR) NULL,
+    "CREATED_AT" TIMESTAMP NOT NULL,
+    FOREIGN KEY ("CUSTOMER_ID") REFERENCES "CUSTOMER" ("ID") ON DELETE SET NULL,
+    PRIMARY KEY ("ID")
+);
+
+CREATE INDEX "CREATED_AT_IDX" ON "ORDER" ("CREATED_AT");
+CREATE UNIQUE INDEX "CODE_UNIQ_IDX" ON "ORDER" ("CODE");
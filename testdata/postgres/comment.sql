@@ -0,0 +1,15 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "journal" CASCADE;
+
+CREATE TABLE "journal" (
+    "id" BIGINT NOT NULL,
+    "title" TEXT NOT NULL,
+    "body" TEXT NULL,
+    PRIMARY KEY ("id")
+);
+COMMENT ON TABLE "journal" IS 'User''s journals (C:\journals)';
+COMMENT ON COLUMN "journal"."id" IS 'Journal ID';
+COMMENT ON COLUMN "journal"."title" IS 'It''s the title, not the body';
+
+COMMIT;
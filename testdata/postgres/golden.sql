@@ -0,0 +1,29 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "account" CASCADE;
+
+CREATE TABLE "account" (
+    "id" BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "name" VARCHAR(64) NOT NULL,
+    "active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "avatar" BYTEA NULL,
+    "created_at" TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY ("id")
+);
+
+CREATE UNIQUE INDEX "name_uniq_idx" ON "account" ("name");
+
+DROP TABLE IF EXISTS "post" CASCADE;
+
+CREATE TABLE "post" (
+    "id" BIGINT NOT NULL GENERATED BY DEFAULT AS IDENTITY,
+    "account_id" BIGINT NOT NULL,
+    "body" TEXT NOT NULL,
+    "published_at" TIMESTAMPTZ NULL,
+    "created_at" TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY ("account_id") REFERENCES "account" ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "account_id_created_at_idx" ON "post" ("account_id", "created_at");
+COMMIT;
@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "order" CASCADE;
+
+CREATE TABLE "order" (
+    "id" BIGINT NOT NULL,
+    "customer_id" BIGINT NOT NULL,
+    "code" VARCHAR(32) NOT NULL,
+    "note" TEXT NULL,
+    "created_at" TIMESTAMPTZ NOT NULL,
+    FOREIGN KEY ("customer_id") REFERENCES "customer" ("id") ON DELETE SET NULL ON UPDATE CASCADE,
+    PRIMARY KEY ("id")
+);
+
+CREATE INDEX "created_at_idx" ON "order" ("created_at");
+CREATE UNIQUE INDEX "code_uniq_idx" ON "order" ("code");
+COMMIT;
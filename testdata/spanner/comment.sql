@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS `journal`;
+
+-- User's journals (C:\journals)
+CREATE TABLE `journal` (
+    -- Journal ID
+    `id` INT64 NOT NULL,
+    -- It's the title, not the body
+    `title` STRING(MAX) NOT NULL,
+    `body` STRING(MAX)
+) PRIMARY KEY (`id`);
+
@@ -0,0 +1,24 @@
+DROP TABLE IF EXISTS `album`;
+DROP INDEX IF EXISTS `singer_email_uniq_idx`;
+DROP TABLE IF EXISTS `singer`;
+
+CREATE TABLE `singer` (
+    `singer_id` STRING(36) NOT NULL,
+    `email` STRING(MAX) NOT NULL,
+    `nicknames` ARRAY<STRING(MAX)>,
+    `profile` JSON,
+    `created_at` TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP())
+) PRIMARY KEY (`singer_id`);
+
+CREATE UNIQUE INDEX `singer_email_uniq_idx` ON `singer` (`email`);
+
+CREATE TABLE `album` (
+    `singer_id` STRING(36) NOT NULL,
+    `album_id` INT64 NOT NULL,
+    `label_id` INT64,
+    `title` STRING(1024) NOT NULL,
+    `cover` BYTES(MAX),
+    FOREIGN KEY (`label_id`) REFERENCES `label` (`id`)
+) PRIMARY KEY (`singer_id`, `album_id`),
+  INTERLEAVE IN PARENT `singer` ON DELETE CASCADE;
+
@@ -0,0 +1,15 @@
+DROP INDEX IF EXISTS `created_at_idx`;
+DROP INDEX IF EXISTS `code_uniq_idx`;
+DROP TABLE IF EXISTS `order`;
+
+CREATE TABLE `order` (
+    `id` INT64 NOT NULL,
+    `customer_id` INT64 NOT NULL,
+    `code` STRING(32) NOT NULL,
+    `note` STRING(MAX),
+    `created_at` TIMESTAMP NOT NULL,
+    FOREIGN KEY (`customer_id`) REFERENCES `customer` (`id`)
+) PRIMARY KEY (`id`);
+
+CREATE INDEX `created_at_idx` ON `order` (`created_at`);
+CREATE UNIQUE INDEX `code_uniq_idx` ON `order` (`code`);
@@ -0,0 +1,15 @@
+PRAGMA foreign_keys = false;
+
+DROP TABLE IF EXISTS `journal`;
+
+-- User's journals (C:\journals)
+CREATE TABLE `journal` (
+    -- Journal ID
+    `id` INTEGER NOT NULL,
+    -- It's the title, not the body
+    `title` TEXT NOT NULL,
+    `body` TEXT NULL,
+    PRIMARY KEY (`id`)
+);
+
+PRAGMA foreign_keys = true;
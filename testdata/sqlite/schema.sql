@@ -0,0 +1,17 @@
+PRAGMA foreign_keys = false;
+
+DROP TABLE IF EXISTS `order`;
+
+CREATE TABLE `order` (
+    `id` INTEGER NOT NULL,
+    `customer_id` INTEGER NOT NULL,
+    `code` TEXT NOT NULL,
+    `note` TEXT NULL,
+    `created_at` INTEGER NOT NULL,
+    FOREIGN KEY (`customer_id`) REFERENCES `customer` (`id`) ON DELETE SET NULL ON UPDATE CASCADE,
+    PRIMARY KEY (`id`)
+);
+
+CREATE INDEX `created_at_idx` ON `order` (`created_at`);
+CREATE UNIQUE INDEX `code_uniq_idx` ON `order` (`code`);
+PRAGMA foreign_keys = true;
@@ -0,0 +1,6 @@
+// Package uuid has UUID type that has the same package name and type name as
+// github.com/google/uuid, so that the tests do not depend on it.
+package uuid
+
+// UUID is a 128 bit (16 byte) Universal Unique IDentifier
+type UUID [16]byte